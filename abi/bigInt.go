@@ -0,0 +1,38 @@
+package abi
+
+import (
+	"math/big"
+)
+
+// signedBytes returns the minimal big endian two's complement representation of the value, empty for zero
+func signedBytes(value *big.Int) []byte {
+	switch value.Sign() {
+	case 0:
+		return make([]byte, 0)
+	case 1:
+		result := value.Bytes()
+		if result[0]&0x80 != 0 {
+			result = append([]byte{0}, result...)
+		}
+		return result
+	default:
+		// the number of bytes holding the value is given by the bit length of |value| - 1
+		magnitudeMinusOne := big.NewInt(0).Neg(value)
+		magnitudeMinusOne.Sub(magnitudeMinusOne, big.NewInt(1))
+		numBytes := magnitudeMinusOne.BitLen()/8 + 1
+
+		twosComplement := big.NewInt(0).Lsh(big.NewInt(1), uint(numBytes*8))
+		twosComplement.Add(twosComplement, value)
+		return twosComplement.FillBytes(make([]byte, numBytes))
+	}
+}
+
+// fromSignedBytes returns the value of the big endian two's complement representation, zero for empty input
+func fromSignedBytes(data []byte) *big.Int {
+	value := big.NewInt(0).SetBytes(data)
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		value.Sub(value, big.NewInt(0).Lsh(big.NewInt(1), uint(len(data)*8)))
+	}
+
+	return value
+}
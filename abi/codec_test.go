@@ -0,0 +1,204 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAddress = bytes.Repeat([]byte{1}, AddressLength)
+
+func checkEncoding(t *testing.T, value interface{}, td *TypeDescriptor, expectedTopLevel string, expectedNested string) {
+	topLevel, err := EncodeTopLevel(value, td)
+	require.Nil(t, err)
+	assert.Equal(t, expectedTopLevel, hex.EncodeToString(topLevel), "top level encoding of %s", td)
+
+	nested, err := EncodeNested(value, td)
+	require.Nil(t, err)
+	assert.Equal(t, expectedNested, hex.EncodeToString(nested), "nested encoding of %s", td)
+
+	decoded, err := DecodeTopLevel(topLevel, td)
+	require.Nil(t, err)
+	assert.Equal(t, value, decoded, "top level decoding of %s", td)
+
+	decoded, rest, err := DecodeNested(append(nested, 0xaa), td)
+	require.Nil(t, err)
+	assert.Equal(t, value, decoded, "nested decoding of %s", td)
+	assert.Equal(t, []byte{0xaa}, rest)
+}
+
+func TestCodec_Numbers(t *testing.T) {
+	t.Parallel()
+
+	checkEncoding(t, uint8(0), U8(), "", "00")
+	checkEncoding(t, uint8(255), U8(), "ff", "ff")
+	checkEncoding(t, uint16(256), U16(), "0100", "0100")
+	checkEncoding(t, uint32(5), U32(), "05", "00000005")
+	checkEncoding(t, uint64(math.MaxUint64), U64(), "ffffffffffffffff", "ffffffffffffffff")
+	checkEncoding(t, int64(0), I64(), "", "0000000000000000")
+	checkEncoding(t, int64(127), I64(), "7f", "000000000000007f")
+	checkEncoding(t, int64(128), I64(), "0080", "0000000000000080")
+	checkEncoding(t, int64(-1), I64(), "ff", "ffffffffffffffff")
+	checkEncoding(t, int64(-129), I64(), "ff7f", "ffffffffffffff7f")
+	checkEncoding(t, int64(math.MinInt64), I64(), "8000000000000000", "8000000000000000")
+	checkEncoding(t, big.NewInt(0), BigUint(), "", "00000000")
+	checkEncoding(t, big.NewInt(1000), BigUint(), "03e8", "0000000203e8")
+	checkEncoding(t, big.NewInt(0), BigInt(), "", "00000000")
+	checkEncoding(t, big.NewInt(255), BigInt(), "00ff", "0000000200ff")
+	checkEncoding(t, big.NewInt(-128), BigInt(), "80", "0000000180")
+	checkEncoding(t, big.NewInt(-256), BigInt(), "ff00", "00000002ff00")
+}
+
+func TestCodec_BytesAndBool(t *testing.T) {
+	t.Parallel()
+
+	checkEncoding(t, testAddress, Address(), hex.EncodeToString(testAddress), hex.EncodeToString(testAddress))
+	checkEncoding(t, []byte("TKN-abcdef"), TokenIdentifier(), "544b4e2d616263646566", "0000000a544b4e2d616263646566")
+	checkEncoding(t, true, Bool(), "01", "01")
+	checkEncoding(t, false, Bool(), "", "00")
+}
+
+func TestCodec_OptionAndList(t *testing.T) {
+	t.Parallel()
+
+	checkEncoding(t, nil, Option(U32()), "", "00")
+	checkEncoding(t, uint32(7), Option(U32()), "0100000007", "0100000007")
+	checkEncoding(t, []interface{}{uint16(1), uint16(2)}, List(U16()), "00010002", "0000000200010002")
+	checkEncoding(t, []interface{}{}, List(BigUint()), "", "00000000")
+	checkEncoding(t,
+		[]interface{}{[]interface{}{true}, []interface{}{}},
+		List(List(Bool())),
+		"000000010100000000",
+		"00000002000000010100000000",
+	)
+}
+
+func TestCodec_Struct(t *testing.T) {
+	t.Parallel()
+
+	payment := Struct("Payment",
+		Field("token", TokenIdentifier()),
+		Field("nonce", U64()),
+		Field("amount", BigUint()),
+	)
+	value := map[string]interface{}{
+		"token":  []byte("TKN-abcdef"),
+		"nonce":  uint64(1),
+		"amount": big.NewInt(10),
+	}
+	expected := "0000000a544b4e2d616263646566" + "0000000000000001" + "000000010a"
+	checkEncoding(t, value, payment, expected, expected)
+
+	listOfPayments := List(payment)
+	checkEncoding(t, []interface{}{value}, listOfPayments, expected, "00000001"+expected)
+
+	_, err := EncodeTopLevel(map[string]interface{}{"token": []byte("TKN-abcdef")}, payment)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	value["extra"] = true
+	_, err = EncodeTopLevel(value, payment)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	assert.Equal(t, "List<Payment>", listOfPayments.String())
+	assert.Equal(t, "struct{a: Option<u8>}", Struct("", Field("a", Option(U8()))).String())
+}
+
+func TestCodec_EncodeErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := EncodeTopLevel(uint8(1), nil)
+	assert.Equal(t, ErrNilTypeDescriptor, err)
+
+	_, err = EncodeNested(uint8(1), &TypeDescriptor{Kind: 100})
+	assert.True(t, errors.Is(err, ErrUnknownTypeKind))
+
+	_, err = EncodeNested(nil, List(nil))
+	assert.True(t, errors.Is(err, ErrNilTypeDescriptor))
+
+	invalidValues := []struct {
+		value interface{}
+		td    *TypeDescriptor
+	}{
+		{256, U8()},
+		{uint32(math.MaxUint16 + 1), U16()},
+		{-1, U64()},
+		{"1", U32()},
+		{uint64(1), I64()},
+		{big.NewInt(-1), BigUint()},
+		{(*big.Int)(nil), BigInt()},
+		{[]byte("short"), Address()},
+		{1, TokenIdentifier()},
+		{1, Bool()},
+		{[]uint8{1}, List(U8())},
+		{[]interface{}{"a"}, List(U8())},
+		{"a", Option(U8())},
+	}
+	for _, invalid := range invalidValues {
+		_, err = EncodeTopLevel(invalid.value, invalid.td)
+		assert.True(t, errors.Is(err, ErrInvalidValue), "%v for %s", invalid.value, invalid.td)
+	}
+}
+
+func TestCodec_DecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeTopLevel([]byte{1, 2}, U8())
+	assert.True(t, errors.Is(err, ErrInputTooLong))
+
+	_, err = DecodeTopLevel(make([]byte, 9), I64())
+	assert.True(t, errors.Is(err, ErrInputTooLong))
+
+	_, err = DecodeTopLevel([]byte{2}, Bool())
+	assert.True(t, errors.Is(err, ErrInvalidBool))
+
+	_, err = DecodeTopLevel([]byte{2, 0}, Option(U8()))
+	assert.True(t, errors.Is(err, ErrInvalidOptionTag))
+
+	_, err = DecodeTopLevel([]byte{1, 1, 1}, Option(U8()))
+	assert.True(t, errors.Is(err, ErrInputTooLong))
+
+	_, err = DecodeTopLevel([]byte{0, 1, 2}, List(U16()))
+	assert.True(t, errors.Is(err, ErrInputTooShort))
+
+	_, err = DecodeTopLevel([]byte{1, 2}, Address())
+	assert.True(t, errors.Is(err, ErrInputTooShort))
+
+	_, _, err = DecodeNested([]byte{0, 0, 0, 5, 1}, BigUint())
+	assert.True(t, errors.Is(err, ErrInputTooShort))
+
+	_, _, err = DecodeNested([]byte{0xff, 0xff, 0xff, 0xff}, List(U8()))
+	assert.True(t, errors.Is(err, ErrInputTooShort))
+
+	_, _, err = DecodeNested(nil, nil)
+	assert.Equal(t, ErrNilTypeDescriptor, err)
+}
+
+func TestCodec_Arguments(t *testing.T) {
+	t.Parallel()
+
+	types := []*TypeDescriptor{U64(), Option(Address()), BigInt()}
+	values := []interface{}{uint64(10), testAddress, big.NewInt(-5)}
+
+	arguments, err := EncodeArguments(values, types)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{{10}, append([]byte{1}, testAddress...), {0xfb}}, arguments)
+
+	decoded, err := DecodeArguments(arguments, types)
+	require.Nil(t, err)
+	assert.Equal(t, values, decoded)
+
+	_, err = EncodeArguments(values, types[:1])
+	assert.True(t, errors.Is(err, ErrNumArgumentsMismatch))
+
+	_, err = DecodeArguments(arguments[:1], types)
+	assert.True(t, errors.Is(err, ErrNumArgumentsMismatch))
+
+	_, err = DecodeArguments([][]byte{{1}, {2}, {}}, types)
+	assert.True(t, errors.Is(err, ErrInvalidOptionTag))
+}
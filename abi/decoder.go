@@ -0,0 +1,264 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+// DecodeTopLevel decodes a standalone argument encoded by EncodeTopLevel
+func DecodeTopLevel(data []byte, td *TypeDescriptor) (interface{}, error) {
+	err := checkTypeDescriptor(td)
+	if err != nil {
+		return nil, err
+	}
+
+	switch td.Kind {
+	case KindU8, KindU16, KindU32, KindU64:
+		if len(data) > td.fixedSize() {
+			return nil, newDecodeError(ErrInputTooLong, td, len(data))
+		}
+		return fromUint64(big.NewInt(0).SetBytes(data).Uint64(), td), nil
+	case KindI64:
+		if len(data) > td.fixedSize() {
+			return nil, newDecodeError(ErrInputTooLong, td, len(data))
+		}
+		return fromSignedBytes(data).Int64(), nil
+	case KindBigUint:
+		return big.NewInt(0).SetBytes(data), nil
+	case KindBigInt:
+		return fromSignedBytes(data), nil
+	case KindTokenIdentifier:
+		return append(make([]byte, 0, len(data)), data...), nil
+	case KindBool:
+		if len(data) == 0 {
+			return false, nil
+		}
+		if len(data) == 1 && data[0] == 1 {
+			return true, nil
+		}
+		return nil, fmt.Errorf("%w: %x", ErrInvalidBool, data)
+	case KindOption:
+		if len(data) == 0 {
+			return nil, nil
+		}
+		return decodeAll(data, td)
+	case KindList:
+		items := make([]interface{}, 0)
+		reader := &nestedReader{data: data}
+		for len(reader.data) > 0 {
+			item, errDecode := reader.decode(td.Inner)
+			if errDecode != nil {
+				return nil, errDecode
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		return decodeAll(data, td)
+	}
+}
+
+// DecodeNested decodes a value encoded by EncodeNested, returning the bytes following it
+func DecodeNested(data []byte, td *TypeDescriptor) (interface{}, []byte, error) {
+	reader := &nestedReader{data: data}
+	value, err := reader.decode(td)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return value, reader.data, nil
+}
+
+// DecodeArguments decodes each top level argument, using the type descriptor at the same index
+func DecodeArguments(arguments [][]byte, types []*TypeDescriptor) ([]interface{}, error) {
+	if len(arguments) != len(types) {
+		return nil, fmt.Errorf("%w: %d arguments, %d types", ErrNumArgumentsMismatch, len(arguments), len(types))
+	}
+
+	values := make([]interface{}, 0, len(arguments))
+	for i, argument := range arguments {
+		value, err := DecodeTopLevel(argument, types[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w for argument %d", err, i)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// decodeAll decodes a nested encoded value, which must use all the provided bytes
+func decodeAll(data []byte, td *TypeDescriptor) (interface{}, error) {
+	value, rest, err := DecodeNested(data, td)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, newDecodeError(ErrInputTooLong, td, len(rest))
+	}
+
+	return value, nil
+}
+
+type nestedReader struct {
+	data []byte
+}
+
+func (reader *nestedReader) read(td *TypeDescriptor, numBytes int) ([]byte, error) {
+	if numBytes > len(reader.data) {
+		return nil, newDecodeError(ErrInputTooShort, td, len(reader.data))
+	}
+
+	result := reader.data[:numBytes]
+	reader.data = reader.data[numBytes:]
+
+	return result, nil
+}
+
+func (reader *nestedReader) readLength(td *TypeDescriptor) (int, error) {
+	encodedLength, err := reader.read(td, lengthPrefixSize)
+	if err != nil {
+		return 0, err
+	}
+
+	length := binary.BigEndian.Uint32(encodedLength)
+	if uint64(length) > uint64(len(reader.data)) {
+		return 0, newDecodeError(ErrInputTooShort, td, len(reader.data))
+	}
+
+	return int(length), nil
+}
+
+func (reader *nestedReader) readLengthPrefixed(td *TypeDescriptor) ([]byte, error) {
+	length, err := reader.readLength(td)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.read(td, length)
+}
+
+func (reader *nestedReader) decode(td *TypeDescriptor) (interface{}, error) {
+	err := checkTypeDescriptor(td)
+	if err != nil {
+		return nil, err
+	}
+
+	switch td.Kind {
+	case KindU8, KindU16, KindU32, KindU64:
+		encoded, errRead := reader.read(td, td.fixedSize())
+		if errRead != nil {
+			return nil, errRead
+		}
+		return fromUint64(big.NewInt(0).SetBytes(encoded).Uint64(), td), nil
+	case KindI64:
+		encoded, errRead := reader.read(td, td.fixedSize())
+		if errRead != nil {
+			return nil, errRead
+		}
+		return int64(binary.BigEndian.Uint64(encoded)), nil
+	case KindBigUint, KindBigInt, KindTokenIdentifier:
+		encoded, errRead := reader.readLengthPrefixed(td)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return DecodeTopLevel(encoded, td)
+	case KindAddress:
+		encoded, errRead := reader.read(td, AddressLength)
+		if errRead != nil {
+			return nil, errRead
+		}
+		return append(make([]byte, 0, AddressLength), encoded...), nil
+	case KindBool:
+		encoded, errRead := reader.read(td, 1)
+		if errRead != nil {
+			return nil, errRead
+		}
+		if encoded[0] > 1 {
+			return nil, fmt.Errorf("%w: %x", ErrInvalidBool, encoded)
+		}
+		return encoded[0] == 1, nil
+	case KindOption:
+		return reader.decodeOption(td)
+	case KindList:
+		return reader.decodeList(td)
+	case KindStruct:
+		return reader.decodeStruct(td)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownTypeKind, uint8(td.Kind))
+	}
+}
+
+func (reader *nestedReader) decodeOption(td *TypeDescriptor) (interface{}, error) {
+	tag, err := reader.read(td, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag[0] {
+	case 0:
+		return nil, nil
+	case 1:
+		return reader.decode(td.Inner)
+	default:
+		return nil, fmt.Errorf("%w: %x", ErrInvalidOptionTag, tag)
+	}
+}
+
+func (reader *nestedReader) decodeList(td *TypeDescriptor) (interface{}, error) {
+	encodedNumItems, err := reader.read(td, lengthPrefixSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// every item takes at least one byte, so the number of items is bounded by the input. Lists of empty structs are
+	// therefore not supported, as their items could not be bounded.
+	numItems := binary.BigEndian.Uint32(encodedNumItems)
+	if uint64(numItems) > uint64(len(reader.data)) {
+		return nil, newDecodeError(ErrInputTooShort, td, len(reader.data))
+	}
+
+	items := make([]interface{}, 0, numItems)
+	for i := uint32(0); i < numItems; i++ {
+		item, errDecode := reader.decode(td.Inner)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (reader *nestedReader) decodeStruct(td *TypeDescriptor) (interface{}, error) {
+	fields := make(map[string]interface{}, len(td.Fields))
+	for _, field := range td.Fields {
+		value, err := reader.decode(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w in field %s of %s", err, field.Name, td)
+		}
+
+		fields[field.Name] = value
+	}
+
+	return fields, nil
+}
+
+func fromUint64(number uint64, td *TypeDescriptor) interface{} {
+	switch td.Kind {
+	case KindU8:
+		return uint8(number)
+	case KindU16:
+		return uint16(number)
+	case KindU32:
+		return uint32(number)
+	default:
+		return number
+	}
+}
+
+func newDecodeError(err error, td *TypeDescriptor, numBytes int) error {
+	return fmt.Errorf("%w while decoding %s, %d bytes left", err, td, numBytes)
+}
@@ -0,0 +1,326 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+)
+
+const lengthPrefixSize = 4
+
+// EncodeTopLevel encodes the value as a standalone argument. Numbers are encoded on the minimum number of bytes,
+// lists and structs as the concatenation of their nested encoded items.
+func EncodeTopLevel(value interface{}, td *TypeDescriptor) ([]byte, error) {
+	err := checkTypeDescriptor(td)
+	if err != nil {
+		return nil, err
+	}
+
+	switch td.Kind {
+	case KindU8, KindU16, KindU32, KindU64:
+		number, errConvert := toUint64(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		return big.NewInt(0).SetUint64(number).Bytes(), nil
+	case KindI64:
+		number, errConvert := toInt64(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		return signedBytes(big.NewInt(number)), nil
+	case KindBigUint:
+		number, errConvert := toBigInt(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		return number.Bytes(), nil
+	case KindBigInt:
+		number, errConvert := toBigInt(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		return signedBytes(number), nil
+	case KindTokenIdentifier:
+		return toBytes(value, td)
+	case KindBool:
+		flag, errConvert := toBool(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		if !flag {
+			return make([]byte, 0), nil
+		}
+		return []byte{1}, nil
+	case KindOption:
+		if value == nil {
+			return make([]byte, 0), nil
+		}
+		return EncodeNested(value, td)
+	case KindList:
+		items, errConvert := toList(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		return encodeNestedItems(items, td.Inner)
+	default:
+		return EncodeNested(value, td)
+	}
+}
+
+// EncodeNested encodes the value as part of an enclosing value. Fixed size numbers keep their full size, while the
+// variable size values are prefixed by their 4 bytes big endian length.
+func EncodeNested(value interface{}, td *TypeDescriptor) ([]byte, error) {
+	err := checkTypeDescriptor(td)
+	if err != nil {
+		return nil, err
+	}
+
+	switch td.Kind {
+	case KindU8, KindU16, KindU32, KindU64:
+		number, errConvert := toUint64(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		encoded := make([]byte, 8)
+		binary.BigEndian.PutUint64(encoded, number)
+		return encoded[8-td.fixedSize():], nil
+	case KindI64:
+		number, errConvert := toInt64(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		encoded := make([]byte, 8)
+		binary.BigEndian.PutUint64(encoded, uint64(number))
+		return encoded, nil
+	case KindBigUint, KindBigInt, KindTokenIdentifier:
+		encoded, errEncode := EncodeTopLevel(value, td)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		return prefixWithLength(encoded), nil
+	case KindAddress:
+		return toAddress(value, td)
+	case KindBool:
+		flag, errConvert := toBool(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		if !flag {
+			return []byte{0}, nil
+		}
+		return []byte{1}, nil
+	case KindOption:
+		if value == nil {
+			return []byte{0}, nil
+		}
+		encoded, errEncode := EncodeNested(value, td.Inner)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		return append([]byte{1}, encoded...), nil
+	case KindList:
+		items, errConvert := toList(value, td)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		encoded, errEncode := encodeNestedItems(items, td.Inner)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		return append(encodeLength(len(items)), encoded...), nil
+	case KindStruct:
+		return encodeStruct(value, td)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownTypeKind, uint8(td.Kind))
+	}
+}
+
+// EncodeArguments encodes each value as a top level argument, using the type descriptor at the same index
+func EncodeArguments(values []interface{}, types []*TypeDescriptor) ([][]byte, error) {
+	if len(values) != len(types) {
+		return nil, fmt.Errorf("%w: %d values, %d types", ErrNumArgumentsMismatch, len(values), len(types))
+	}
+
+	arguments := make([][]byte, 0, len(values))
+	for i, value := range values {
+		argument, err := EncodeTopLevel(value, types[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w for argument %d", err, i)
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	return arguments, nil
+}
+
+func encodeNestedItems(items []interface{}, td *TypeDescriptor) ([]byte, error) {
+	encoded := make([]byte, 0)
+	for _, item := range items {
+		encodedItem, err := EncodeNested(item, td)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, encodedItem...)
+	}
+
+	return encoded, nil
+}
+
+func encodeStruct(value interface{}, td *TypeDescriptor) ([]byte, error) {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, newInvalidValueError(value, td)
+	}
+
+	knownFields := make(map[string]struct{}, len(td.Fields))
+	encoded := make([]byte, 0)
+	for _, field := range td.Fields {
+		knownFields[field.Name] = struct{}{}
+		fieldValue, found := fields[field.Name]
+		if !found {
+			return nil, fmt.Errorf("%w: missing field %s of %s", ErrInvalidValue, field.Name, td)
+		}
+
+		encodedField, err := EncodeNested(fieldValue, field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w in field %s of %s", err, field.Name, td)
+		}
+		encoded = append(encoded, encodedField...)
+	}
+
+	unknownFields := make([]string, 0)
+	for name := range fields {
+		_, found := knownFields[name]
+		if !found {
+			unknownFields = append(unknownFields, name)
+		}
+	}
+	if len(unknownFields) > 0 {
+		sort.Strings(unknownFields)
+		return nil, fmt.Errorf("%w: unknown fields %v of %s", ErrInvalidValue, unknownFields, td)
+	}
+
+	return encoded, nil
+}
+
+func encodeLength(length int) []byte {
+	encoded := make([]byte, lengthPrefixSize)
+	binary.BigEndian.PutUint32(encoded, uint32(length))
+
+	return encoded
+}
+
+func prefixWithLength(data []byte) []byte {
+	return append(encodeLength(len(data)), data...)
+}
+
+func toUint64(value interface{}, td *TypeDescriptor) (uint64, error) {
+	var number uint64
+	switch v := value.(type) {
+	case uint8:
+		number = uint64(v)
+	case uint16:
+		number = uint64(v)
+	case uint32:
+		number = uint64(v)
+	case uint64:
+		number = v
+	case uint:
+		number = uint64(v)
+	case int:
+		if v < 0 {
+			return 0, newInvalidValueError(value, td)
+		}
+		number = uint64(v)
+	case int64:
+		if v < 0 {
+			return 0, newInvalidValueError(value, td)
+		}
+		number = uint64(v)
+	default:
+		return 0, newInvalidValueError(value, td)
+	}
+
+	maxValue := uint64(math.MaxUint64) >> (64 - 8*uint(td.fixedSize()))
+	if number > maxValue {
+		return 0, newInvalidValueError(value, td)
+	}
+
+	return number, nil
+}
+
+func toInt64(value interface{}, td *TypeDescriptor) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	default:
+		return 0, newInvalidValueError(value, td)
+	}
+}
+
+func toBigInt(value interface{}, td *TypeDescriptor) (*big.Int, error) {
+	number, ok := value.(*big.Int)
+	if !ok || number == nil {
+		return nil, newInvalidValueError(value, td)
+	}
+	if td.Kind == KindBigUint && number.Sign() < 0 {
+		return nil, newInvalidValueError(value, td)
+	}
+
+	return number, nil
+}
+
+func toBytes(value interface{}, td *TypeDescriptor) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return append(make([]byte, 0, len(v)), v...), nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, newInvalidValueError(value, td)
+	}
+}
+
+func toAddress(value interface{}, td *TypeDescriptor) ([]byte, error) {
+	address, ok := value.([]byte)
+	if !ok || len(address) != AddressLength {
+		return nil, newInvalidValueError(value, td)
+	}
+
+	return append(make([]byte, 0, AddressLength), address...), nil
+}
+
+func toBool(value interface{}, td *TypeDescriptor) (bool, error) {
+	flag, ok := value.(bool)
+	if !ok {
+		return false, newInvalidValueError(value, td)
+	}
+
+	return flag, nil
+}
+
+func toList(value interface{}, td *TypeDescriptor) ([]interface{}, error) {
+	if value == nil {
+		return make([]interface{}, 0), nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, newInvalidValueError(value, td)
+	}
+
+	return items, nil
+}
+
+func newInvalidValueError(value interface{}, td *TypeDescriptor) error {
+	return fmt.Errorf("%w: %v (%T) for %s", ErrInvalidValue, value, value, td)
+}
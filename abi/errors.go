@@ -0,0 +1,27 @@
+package abi
+
+import "errors"
+
+// ErrNilTypeDescriptor signals that a nil type descriptor has been provided
+var ErrNilTypeDescriptor = errors.New("nil type descriptor")
+
+// ErrUnknownTypeKind signals that a type descriptor with an unknown kind has been provided
+var ErrUnknownTypeKind = errors.New("unknown type kind")
+
+// ErrInvalidValue signals that the value does not match the type descriptor
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInputTooShort signals that the encoded data ended before the value was fully decoded
+var ErrInputTooShort = errors.New("input too short")
+
+// ErrInputTooLong signals that the encoded data holds more bytes than the decoded value
+var ErrInputTooLong = errors.New("input too long")
+
+// ErrInvalidBool signals that an encoded bool is neither 0 nor 1
+var ErrInvalidBool = errors.New("invalid bool")
+
+// ErrInvalidOptionTag signals that an encoded option does not start with 0 or 1
+var ErrInvalidOptionTag = errors.New("invalid option tag")
+
+// ErrNumArgumentsMismatch signals that the number of arguments does not match the number of type descriptors
+var ErrNumArgumentsMismatch = errors.New("number of arguments does not match the number of types")
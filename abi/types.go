@@ -0,0 +1,219 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddressLength is the length of an encoded address
+const AddressLength = 32
+
+// TypeKind is the kind of value described by a TypeDescriptor
+type TypeKind uint8
+
+const (
+	// KindU8 is an unsigned 8 bit integer, decoded as uint8
+	KindU8 TypeKind = iota + 1
+	// KindU16 is an unsigned 16 bit integer, decoded as uint16
+	KindU16
+	// KindU32 is an unsigned 32 bit integer, decoded as uint32
+	KindU32
+	// KindU64 is an unsigned 64 bit integer, decoded as uint64
+	KindU64
+	// KindI64 is a signed 64 bit integer, decoded as int64
+	KindI64
+	// KindBigUint is an arbitrary size unsigned integer, decoded as *big.Int
+	KindBigUint
+	// KindBigInt is an arbitrary size signed integer, decoded as *big.Int
+	KindBigInt
+	// KindAddress is a 32 bytes address, decoded as []byte
+	KindAddress
+	// KindTokenIdentifier is a token identifier, decoded as []byte
+	KindTokenIdentifier
+	// KindBool is a boolean, decoded as bool
+	KindBool
+	// KindOption is an optional value, decoded as nil or as the value of the inner type
+	KindOption
+	// KindList is a list of values of the inner type, decoded as []interface{}
+	KindList
+	// KindStruct is a list of named fields, decoded as map[string]interface{}
+	KindStruct
+)
+
+var kindNames = map[TypeKind]string{
+	KindU8:              "u8",
+	KindU16:             "u16",
+	KindU32:             "u32",
+	KindU64:             "u64",
+	KindI64:             "i64",
+	KindBigUint:         "BigUint",
+	KindBigInt:          "BigInt",
+	KindAddress:         "Address",
+	KindTokenIdentifier: "TokenIdentifier",
+	KindBool:            "bool",
+	KindOption:          "Option",
+	KindList:            "List",
+	KindStruct:          "struct",
+}
+
+// String returns the name of the kind
+func (kind TypeKind) String() string {
+	name, found := kindNames[kind]
+	if !found {
+		return fmt.Sprintf("unknown(%d)", uint8(kind))
+	}
+
+	return name
+}
+
+// TypeDescriptor describes the type of an encoded value. Inner is used by the Option and List kinds, while Fields is
+// used by the struct kind.
+type TypeDescriptor struct {
+	Kind   TypeKind
+	Name   string
+	Inner  *TypeDescriptor
+	Fields []*FieldDescriptor
+}
+
+// FieldDescriptor describes a named field of a struct
+type FieldDescriptor struct {
+	Name string
+	Type *TypeDescriptor
+}
+
+// U8 returns the descriptor of an unsigned 8 bit integer
+func U8() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindU8}
+}
+
+// U16 returns the descriptor of an unsigned 16 bit integer
+func U16() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindU16}
+}
+
+// U32 returns the descriptor of an unsigned 32 bit integer
+func U32() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindU32}
+}
+
+// U64 returns the descriptor of an unsigned 64 bit integer
+func U64() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindU64}
+}
+
+// I64 returns the descriptor of a signed 64 bit integer
+func I64() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindI64}
+}
+
+// BigUint returns the descriptor of an arbitrary size unsigned integer
+func BigUint() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindBigUint}
+}
+
+// BigInt returns the descriptor of an arbitrary size signed integer
+func BigInt() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindBigInt}
+}
+
+// Address returns the descriptor of an address
+func Address() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindAddress}
+}
+
+// TokenIdentifier returns the descriptor of a token identifier
+func TokenIdentifier() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindTokenIdentifier}
+}
+
+// Bool returns the descriptor of a boolean
+func Bool() *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindBool}
+}
+
+// Option returns the descriptor of an optional value of the inner type
+func Option(inner *TypeDescriptor) *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindOption, Inner: inner}
+}
+
+// List returns the descriptor of a list of values of the inner type
+func List(inner *TypeDescriptor) *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindList, Inner: inner}
+}
+
+// Struct returns the descriptor of a named struct with the provided fields, encoded in the provided order
+func Struct(name string, fields ...*FieldDescriptor) *TypeDescriptor {
+	return &TypeDescriptor{Kind: KindStruct, Name: name, Fields: fields}
+}
+
+// Field returns the descriptor of a struct field
+func Field(name string, fieldType *TypeDescriptor) *FieldDescriptor {
+	return &FieldDescriptor{Name: name, Type: fieldType}
+}
+
+// String returns the type name, as written in the contract ABI
+func (td *TypeDescriptor) String() string {
+	if td == nil {
+		return "nil"
+	}
+
+	switch td.Kind {
+	case KindOption, KindList:
+		return fmt.Sprintf("%s<%s>", td.Kind, td.Inner)
+	case KindStruct:
+		if len(td.Name) > 0 {
+			return td.Name
+		}
+
+		fieldNames := make([]string, 0, len(td.Fields))
+		for _, field := range td.Fields {
+			fieldNames = append(fieldNames, field.Name+": "+field.Type.String())
+		}
+		return "struct{" + strings.Join(fieldNames, ", ") + "}"
+	default:
+		return td.Kind.String()
+	}
+}
+
+// fixedSize returns the number of bytes of the fixed size integers, or 0 for the other kinds
+func (td *TypeDescriptor) fixedSize() int {
+	switch td.Kind {
+	case KindU8:
+		return 1
+	case KindU16:
+		return 2
+	case KindU32:
+		return 4
+	case KindU64, KindI64:
+		return 8
+	default:
+		return 0
+	}
+}
+
+func checkTypeDescriptor(td *TypeDescriptor) error {
+	if td == nil {
+		return ErrNilTypeDescriptor
+	}
+
+	switch td.Kind {
+	case KindOption, KindList:
+		if td.Inner == nil {
+			return fmt.Errorf("%w: missing inner type of %s", ErrNilTypeDescriptor, td.Kind)
+		}
+		return nil
+	case KindStruct:
+		for _, field := range td.Fields {
+			if field == nil || field.Type == nil {
+				return fmt.Errorf("%w: missing field type of %s", ErrNilTypeDescriptor, td)
+			}
+		}
+		return nil
+	default:
+		_, found := kindNames[td.Kind]
+		if !found {
+			return fmt.Errorf("%w: %d", ErrUnknownTypeKind, uint8(td.Kind))
+		}
+		return nil
+	}
+}
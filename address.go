@@ -2,8 +2,10 @@ package vmcommon
 
 import (
 	"bytes"
+	"encoding/hex"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 )
 
 // SystemAccountAddress is the hard-coded address in which we save global settings on all shards
@@ -110,3 +112,64 @@ func ParseVMTypeFromContractAddress(contractAddress []byte) ([]byte, error) {
 	endIndex := NumInitCharactersForScAddress
 	return contractAddress[startIndex:endIndex], nil
 }
+
+// Address is the public key of an account, classified by the same rules as the raw address helpers
+type Address []byte
+
+// Bytes returns the raw address
+func (address Address) Bytes() []byte {
+	return address
+}
+
+// String returns the hex encoded address
+func (address Address) String() string {
+	return hex.EncodeToString(address)
+}
+
+// IsEmpty returns true if the address holds only zero bytes
+func (address Address) IsEmpty() bool {
+	return IsEmptyAddress(address)
+}
+
+// IsSmartContract returns true if the address is of type smart contract
+func (address Address) IsSmartContract() bool {
+	return IsSmartContractAddress(address)
+}
+
+// IsSystemAccount returns true if the address is the system account address
+func (address Address) IsSystemAccount() bool {
+	return IsSystemAccountAddress(address)
+}
+
+// IsMetachainSmartContract returns true if the address is a smart contract deployed on metachain. The shard
+// identifier is the last byte of the address, as computed by the shard coordinator.
+func (address Address) IsMetachainSmartContract() bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	return IsSmartContractOnMetachain(address[len(address)-1:], address)
+}
+
+// IsUser returns true if the address belongs to a user account, being neither a smart contract nor the system account
+func (address Address) IsUser() bool {
+	return len(address) > 0 && !address.IsSmartContract() && !address.IsSystemAccount()
+}
+
+// VMType returns the VM type of the smart contract address
+func (address Address) VMType() ([]byte, error) {
+	if !address.IsSmartContract() {
+		return nil, ErrInvalidVMType
+	}
+
+	return ParseVMTypeFromContractAddress(address)
+}
+
+// ShardID returns the shard of the address, as computed by the provided coordinator
+func (address Address) ShardID(coordinator Coordinator) (uint32, error) {
+	if check.IfNil(coordinator) {
+		return 0, ErrNilShardCoordinator
+	}
+
+	return coordinator.ComputeId(address), nil
+}
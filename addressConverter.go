@@ -0,0 +1,59 @@
+package vmcommon
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/pubkeyConverter"
+)
+
+// DefaultAddressHRP is the human readable part of the bech32 encoded addresses
+const DefaultAddressHRP = "drt"
+
+type addressConverter struct {
+	converter core.PubkeyConverter
+	hrp       string
+}
+
+// NewAddressConverter creates a component able to convert the addresses of the provided length to and from their
+// bech32 representation, using the provided human readable part
+func NewAddressConverter(addressLen int, hrp string) (*addressConverter, error) {
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLen, hrp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &addressConverter{
+		converter: converter,
+		hrp:       hrp,
+	}, nil
+}
+
+// Encode returns the bech32 representation of the address
+func (ac *addressConverter) Encode(address Address) (string, error) {
+	if len(address) != ac.converter.Len() {
+		return "", fmt.Errorf("%w: expected %d, got %d", ErrInvalidAddressLength, ac.converter.Len(), len(address))
+	}
+
+	return ac.converter.Encode(address)
+}
+
+// Decode returns the address from its bech32 representation, which must use the human readable part of the converter
+func (ac *addressConverter) Decode(bech32Address string) (Address, error) {
+	decoded, err := ac.converter.Decode(bech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+// HRP returns the human readable part of the bech32 encoded addresses
+func (ac *addressConverter) HRP() string {
+	return ac.hrp
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ac *addressConverter) IsInterfaceNil() bool {
+	return ac == nil
+}
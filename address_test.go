@@ -1,10 +1,20 @@
 package vmcommon
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"testing"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bech32UserAddress = "drt1kqdm94ef5dr9nz3208rrsdzkgwkz53saj4t5chx26cm4hlq8qz8qa3jfvq"
+	bech32SCAddress   = "drt1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqeyzkqc"
 )
 
 func TestAddress_isSmartContractAddress(t *testing.T) {
@@ -93,3 +103,173 @@ func Test_ParseVMTypeFromContractAddress_Invalid(t *testing.T) {
 	assert.Nil(t, vmType)
 	assert.Equal(t, ErrInvalidVMType, err)
 }
+
+func TestAddress_Classification(t *testing.T) {
+	t.Parallel()
+
+	userAddress := Address(bytes.Repeat([]byte{1}, 32))
+	scAddress, _ := hex.DecodeString("000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
+	metachainSCAddress, _ := hex.DecodeString("000000000000000000010000000000000000000000000000000000000001ffff")
+
+	t.Run("user address", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, userAddress.IsUser())
+		assert.False(t, userAddress.IsSmartContract())
+		assert.False(t, userAddress.IsSystemAccount())
+		assert.False(t, userAddress.IsMetachainSmartContract())
+		assert.False(t, userAddress.IsEmpty())
+	})
+	t.Run("smart contract address", func(t *testing.T) {
+		t.Parallel()
+
+		address := Address(scAddress)
+		assert.True(t, address.IsSmartContract())
+		assert.False(t, address.IsUser())
+		assert.False(t, address.IsMetachainSmartContract())
+	})
+	t.Run("metachain smart contract address", func(t *testing.T) {
+		t.Parallel()
+
+		address := Address(metachainSCAddress)
+		assert.True(t, address.IsSmartContract())
+		assert.True(t, address.IsMetachainSmartContract())
+		assert.Equal(t, IsSmartContractOnMetachain(metachainSCAddress[31:], metachainSCAddress), address.IsMetachainSmartContract())
+	})
+	t.Run("system account address", func(t *testing.T) {
+		t.Parallel()
+
+		address := Address(SystemAccountAddress)
+		assert.True(t, address.IsSystemAccount())
+		assert.False(t, address.IsSmartContract())
+		assert.False(t, address.IsUser())
+	})
+	t.Run("empty address", func(t *testing.T) {
+		t.Parallel()
+
+		address := Address(nil)
+		assert.True(t, address.IsEmpty())
+		assert.False(t, address.IsUser())
+		assert.False(t, address.IsSmartContract())
+		assert.False(t, address.IsMetachainSmartContract())
+	})
+}
+
+func TestAddress_BytesAndString(t *testing.T) {
+	t.Parallel()
+
+	raw, _ := hex.DecodeString("000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
+	address := Address(raw)
+	assert.Equal(t, raw, address.Bytes())
+	assert.Equal(t, "000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1", address.String())
+}
+
+func TestAddress_VMType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("smart contract address should work", func(t *testing.T) {
+		t.Parallel()
+
+		scAddress, _ := hex.DecodeString("00000000000000000a0b5fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
+		vmType, err := Address(scAddress).VMType()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{0x0a, 0x0b}, vmType)
+	})
+	t.Run("user address should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmType, err := Address(bytes.Repeat([]byte{1}, 32)).VMType()
+		assert.Nil(t, vmType)
+		assert.Equal(t, ErrInvalidVMType, err)
+	})
+}
+
+func TestAddress_ShardID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		shardID, err := Address(bytes.Repeat([]byte{1}, 32)).ShardID(nil)
+		assert.Equal(t, uint32(0), shardID)
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("should return the shard computed by the coordinator", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator, _ := sharding.NewMultiShardCoordinator(3, 0)
+		for i := byte(0); i < 6; i++ {
+			address := Address(bytes.Repeat([]byte{i}, 32))
+			shardID, err := address.ShardID(coordinator)
+			assert.Nil(t, err)
+			assert.Equal(t, coordinator.ComputeId(address), shardID)
+		}
+	})
+}
+
+func TestNewAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid human readable part should error", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewAddressConverter(32, "")
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(converter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewAddressConverter(32, DefaultAddressHRP)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(converter))
+		assert.Equal(t, DefaultAddressHRP, converter.HRP())
+	})
+}
+
+func TestAddressConverter_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	converter, err := NewAddressConverter(32, DefaultAddressHRP)
+	require.Nil(t, err)
+
+	t.Run("round trip should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, bech32Address := range []string{bech32UserAddress, bech32SCAddress} {
+			address, errDecode := converter.Decode(bech32Address)
+			require.Nil(t, errDecode)
+
+			encoded, errEncode := converter.Encode(address)
+			require.Nil(t, errEncode)
+			assert.Equal(t, bech32Address, encoded)
+		}
+	})
+	t.Run("decoded addresses should be classified", func(t *testing.T) {
+		t.Parallel()
+
+		userAddress, _ := converter.Decode(bech32UserAddress)
+		assert.True(t, userAddress.IsUser())
+
+		scAddress, _ := converter.Decode(bech32SCAddress)
+		assert.True(t, scAddress.IsSmartContract())
+	})
+	t.Run("other human readable part should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherConverter, _ := NewAddressConverter(32, "erd")
+		address, _ := converter.Decode(bech32UserAddress)
+		encoded, _ := otherConverter.Encode(address)
+
+		decoded, errDecode := converter.Decode(encoded)
+		assert.NotNil(t, errDecode)
+		assert.Nil(t, decoded)
+	})
+	t.Run("invalid address length should error", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, errEncode := converter.Encode(Address{1, 2, 3})
+		assert.True(t, errors.Is(errEncode, ErrInvalidAddressLength))
+		assert.Empty(t, encoded)
+	})
+}
@@ -0,0 +1,501 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.AccountsAdapter = (*accountsOverlay)(nil)
+
+// TouchedKey is a storage key read or written during a simulation
+type TouchedKey struct {
+	Address []byte
+	Key     []byte
+	Read    bool
+	Written bool
+}
+
+type overlayJournalEntry struct {
+	address  string
+	previous vmcommon.AccountHandler
+}
+
+// accountsOverlay is a copy-on-write accounts adapter: accounts are read from the underlying adapter, while all the
+// saved accounts and written storage keys are kept in memory and never reach the underlying adapter
+type accountsOverlay struct {
+	mutOverlay  sync.RWMutex
+	base        vmcommon.AccountsAdapter
+	accounts    map[string]vmcommon.AccountHandler
+	journal     []*overlayJournalEntry
+	touchedKeys map[string]map[string]*TouchedKey
+}
+
+func newAccountsOverlay(base vmcommon.AccountsAdapter) *accountsOverlay {
+	overlay := &accountsOverlay{
+		base: base,
+	}
+	overlay.reset()
+
+	return overlay
+}
+
+// reset discards all the accounts and the touched keys recorded so far
+func (ao *accountsOverlay) reset() {
+	ao.mutOverlay.Lock()
+	defer ao.mutOverlay.Unlock()
+
+	ao.accounts = make(map[string]vmcommon.AccountHandler)
+	ao.journal = make([]*overlayJournalEntry, 0)
+	ao.touchedKeys = make(map[string]map[string]*TouchedKey)
+}
+
+// GetExistingAccount returns the account saved in the overlay, or loads it from the underlying adapter
+func (ao *accountsOverlay) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return ao.getAccount(address, ao.base.GetExistingAccount)
+}
+
+// LoadAccount returns the account saved in the overlay, or loads it from the underlying adapter
+func (ao *accountsOverlay) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return ao.getAccount(address, ao.base.LoadAccount)
+}
+
+func (ao *accountsOverlay) getAccount(
+	address []byte,
+	loadFromBase func(address []byte) (vmcommon.AccountHandler, error),
+) (vmcommon.AccountHandler, error) {
+	ao.mutOverlay.RLock()
+	account, found := ao.accounts[string(address)]
+	ao.mutOverlay.RUnlock()
+	if found {
+		return account, nil
+	}
+
+	account, err := loadFromBase(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return account, nil
+	}
+
+	return ao.newSimulatedAccount(userAccount), nil
+}
+
+// SaveAccount keeps the account in the overlay
+func (ao *accountsOverlay) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return ErrNilUserAccount
+	}
+
+	ao.mutOverlay.Lock()
+	defer ao.mutOverlay.Unlock()
+
+	address := string(account.AddressBytes())
+	ao.journal = append(ao.journal, &overlayJournalEntry{
+		address:  address,
+		previous: ao.accounts[address],
+	})
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	_, isSimulated := account.(*simulatedAccount)
+	if ok && !isSimulated {
+		account = ao.newSimulatedAccount(userAccount)
+	}
+	ao.accounts[address] = account
+
+	return nil
+}
+
+// RemoveAccount is not supported during simulations
+func (ao *accountsOverlay) RemoveAccount(_ []byte) error {
+	return ErrOperationNotSupportedInSimulation
+}
+
+// Commit is not supported during simulations
+func (ao *accountsOverlay) Commit() ([]byte, error) {
+	return nil, ErrOperationNotSupportedInSimulation
+}
+
+// JournalLen returns the number of accounts saved in the overlay
+func (ao *accountsOverlay) JournalLen() int {
+	ao.mutOverlay.RLock()
+	defer ao.mutOverlay.RUnlock()
+
+	return len(ao.journal)
+}
+
+// RevertToSnapshot restores the accounts saved in the overlay before the provided snapshot. As accounts are shared,
+// the changes done on an account object without saving it are not reverted.
+func (ao *accountsOverlay) RevertToSnapshot(snapshot int) error {
+	ao.mutOverlay.Lock()
+	defer ao.mutOverlay.Unlock()
+
+	if snapshot < 0 || snapshot > len(ao.journal) {
+		return ErrInvalidArguments
+	}
+
+	for i := len(ao.journal) - 1; i >= snapshot; i-- {
+		entry := ao.journal[i]
+		if entry.previous == nil {
+			delete(ao.accounts, entry.address)
+			continue
+		}
+
+		ao.accounts[entry.address] = entry.previous
+	}
+	ao.journal = ao.journal[:snapshot]
+
+	return nil
+}
+
+// GetCode returns the code from the underlying adapter
+func (ao *accountsOverlay) GetCode(codeHash []byte) []byte {
+	return ao.base.GetCode(codeHash)
+}
+
+// RootHash returns the root hash of the underlying adapter, as the overlay changes are never committed
+func (ao *accountsOverlay) RootHash() ([]byte, error) {
+	return ao.base.RootHash()
+}
+
+func (ao *accountsOverlay) recordTouchedKey(address []byte, key []byte, isWrite bool) {
+	ao.mutOverlay.Lock()
+	defer ao.mutOverlay.Unlock()
+
+	keysOfAddress, found := ao.touchedKeys[string(address)]
+	if !found {
+		keysOfAddress = make(map[string]*TouchedKey)
+		ao.touchedKeys[string(address)] = keysOfAddress
+	}
+
+	touchedKey, found := keysOfAddress[string(key)]
+	if !found {
+		touchedKey = &TouchedKey{
+			Address: append([]byte(nil), address...),
+			Key:     append([]byte(nil), key...),
+		}
+		keysOfAddress[string(key)] = touchedKey
+	}
+
+	touchedKey.Read = touchedKey.Read || !isWrite
+	touchedKey.Written = touchedKey.Written || isWrite
+}
+
+// getTouchedKeys returns the touched keys sorted by address and key
+func (ao *accountsOverlay) getTouchedKeys() []*TouchedKey {
+	ao.mutOverlay.RLock()
+	defer ao.mutOverlay.RUnlock()
+
+	touchedKeys := make([]*TouchedKey, 0, len(ao.touchedKeys))
+	for _, keysOfAddress := range ao.touchedKeys {
+		for _, touchedKey := range keysOfAddress {
+			keyCopy := *touchedKey
+			touchedKeys = append(touchedKeys, &keyCopy)
+		}
+	}
+
+	sort.Slice(touchedKeys, func(i, j int) bool {
+		addressCmp := bytes.Compare(touchedKeys[i].Address, touchedKeys[j].Address)
+		if addressCmp != 0 {
+			return addressCmp < 0
+		}
+
+		return bytes.Compare(touchedKeys[i].Key, touchedKeys[j].Key) < 0
+	})
+
+	return touchedKeys
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ao *accountsOverlay) IsInterfaceNil() bool {
+	return ao == nil
+}
+
+// simulatedAccount wraps an account loaded from the underlying adapter. The account fields are copied when the account
+// is loaded and the storage writes are kept in memory, so the underlying account is never changed, even if the
+// underlying adapter hands out shared account objects.
+type simulatedAccount struct {
+	vmcommon.UserAccountHandler
+	mutAccount      sync.RWMutex
+	nonce           uint64
+	balance         *big.Int
+	developerReward *big.Int
+	codeMetadata    []byte
+	ownerAddress    []byte
+	userName        []byte
+	dataHandler     *simulatedDataHandler
+}
+
+func (ao *accountsOverlay) newSimulatedAccount(account vmcommon.UserAccountHandler) *simulatedAccount {
+	return &simulatedAccount{
+		UserAccountHandler: account,
+		nonce:              account.GetNonce(),
+		balance:            copyBigInt(account.GetBalance()),
+		developerReward:    copyBigInt(account.GetDeveloperReward()),
+		codeMetadata:       copyBytes(account.GetCodeMetadata()),
+		ownerAddress:       copyBytes(account.GetOwnerAddress()),
+		userName:           copyBytes(account.GetUserName()),
+		dataHandler: &simulatedDataHandler{
+			address:  account.AddressBytes(),
+			base:     account.AccountDataHandler(),
+			written:  make(map[string][]byte),
+			recorder: ao,
+		},
+	}
+}
+
+// IncreaseNonce adds the given value to the simulated nonce
+func (sa *simulatedAccount) IncreaseNonce(value uint64) {
+	sa.mutAccount.Lock()
+	sa.nonce += value
+	sa.mutAccount.Unlock()
+}
+
+// GetNonce returns the simulated nonce
+func (sa *simulatedAccount) GetNonce() uint64 {
+	sa.mutAccount.RLock()
+	defer sa.mutAccount.RUnlock()
+
+	return sa.nonce
+}
+
+// AddToBalance adds the given value to the simulated balance. The resulting balance can not be negative.
+func (sa *simulatedAccount) AddToBalance(value *big.Int) error {
+	if value == nil {
+		return ErrNilValue
+	}
+
+	sa.mutAccount.Lock()
+	defer sa.mutAccount.Unlock()
+
+	newBalance := big.NewInt(0).Add(sa.balance, value)
+	if newBalance.Sign() < 0 {
+		return ErrInsufficientFunds
+	}
+	sa.balance = newBalance
+
+	return nil
+}
+
+// SubFromBalance subtracts the given value from the simulated balance. The resulting balance can not be negative.
+func (sa *simulatedAccount) SubFromBalance(value *big.Int) error {
+	if value == nil {
+		return ErrNilValue
+	}
+
+	return sa.AddToBalance(big.NewInt(0).Neg(value))
+}
+
+// GetBalance returns the simulated balance
+func (sa *simulatedAccount) GetBalance() *big.Int {
+	sa.mutAccount.RLock()
+	defer sa.mutAccount.RUnlock()
+
+	return big.NewInt(0).Set(sa.balance)
+}
+
+// ClaimDeveloperRewards resets the simulated developer reward, returning its previous value. Only the owner can claim it.
+func (sa *simulatedAccount) ClaimDeveloperRewards(sender []byte) (*big.Int, error) {
+	sa.mutAccount.Lock()
+	defer sa.mutAccount.Unlock()
+
+	if !bytes.Equal(sender, sa.ownerAddress) {
+		return nil, ErrOperationNotPermitted
+	}
+
+	oldValue := sa.developerReward
+	sa.developerReward = big.NewInt(0)
+
+	return oldValue, nil
+}
+
+// GetDeveloperReward returns the simulated developer reward
+func (sa *simulatedAccount) GetDeveloperReward() *big.Int {
+	sa.mutAccount.RLock()
+	defer sa.mutAccount.RUnlock()
+
+	return big.NewInt(0).Set(sa.developerReward)
+}
+
+// ChangeOwnerAddress changes the simulated owner address. Only the current owner can change it.
+func (sa *simulatedAccount) ChangeOwnerAddress(sender []byte, newAddress []byte) error {
+	sa.mutAccount.Lock()
+	defer sa.mutAccount.Unlock()
+
+	if !bytes.Equal(sender, sa.ownerAddress) {
+		return ErrOperationNotPermitted
+	}
+	if len(newAddress) != len(sa.AddressBytes()) {
+		return ErrInvalidAddressLength
+	}
+	sa.ownerAddress = copyBytes(newAddress)
+
+	return nil
+}
+
+// SetOwnerAddress sets the simulated owner address
+func (sa *simulatedAccount) SetOwnerAddress(address []byte) {
+	sa.mutAccount.Lock()
+	sa.ownerAddress = copyBytes(address)
+	sa.mutAccount.Unlock()
+}
+
+// GetOwnerAddress returns the simulated owner address
+func (sa *simulatedAccount) GetOwnerAddress() []byte {
+	sa.mutAccount.RLock()
+	defer sa.mutAccount.RUnlock()
+
+	return sa.ownerAddress
+}
+
+// SetUserName sets the simulated user name
+func (sa *simulatedAccount) SetUserName(userName []byte) {
+	sa.mutAccount.Lock()
+	sa.userName = copyBytes(userName)
+	sa.mutAccount.Unlock()
+}
+
+// GetUserName returns the simulated user name
+func (sa *simulatedAccount) GetUserName() []byte {
+	sa.mutAccount.RLock()
+	defer sa.mutAccount.RUnlock()
+
+	return sa.userName
+}
+
+// SetCodeMetadata sets the simulated code metadata
+func (sa *simulatedAccount) SetCodeMetadata(codeMetadata []byte) {
+	sa.mutAccount.Lock()
+	sa.codeMetadata = copyBytes(codeMetadata)
+	sa.mutAccount.Unlock()
+}
+
+// GetCodeMetadata returns the simulated code metadata
+func (sa *simulatedAccount) GetCodeMetadata() []byte {
+	sa.mutAccount.RLock()
+	defer sa.mutAccount.RUnlock()
+
+	return sa.codeMetadata
+}
+
+// AccountDataHandler returns the copy-on-write storage of the account
+func (sa *simulatedAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return sa.dataHandler
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (sa *simulatedAccount) IsInterfaceNil() bool {
+	return sa == nil
+}
+
+func copyBigInt(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
+
+type simulatedDataHandler struct {
+	mutData  sync.RWMutex
+	address  []byte
+	base     vmcommon.AccountDataHandler
+	written  map[string][]byte
+	recorder *accountsOverlay
+}
+
+// RetrieveValue returns the value written during the simulation or the one from the underlying storage
+func (sdh *simulatedDataHandler) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	sdh.recorder.recordTouchedKey(sdh.address, key, false)
+
+	sdh.mutData.RLock()
+	value, found := sdh.written[string(key)]
+	sdh.mutData.RUnlock()
+	if found {
+		return value, 0, nil
+	}
+	if check.IfNil(sdh.base) {
+		return nil, 0, nil
+	}
+
+	return sdh.base.RetrieveValue(key)
+}
+
+// SaveKeyValue keeps the value in memory
+func (sdh *simulatedDataHandler) SaveKeyValue(key []byte, value []byte) error {
+	sdh.recorder.recordTouchedKey(sdh.address, key, true)
+
+	sdh.mutData.Lock()
+	sdh.written[string(key)] = append([]byte(nil), value...)
+	sdh.mutData.Unlock()
+
+	return nil
+}
+
+// IterateKeysWithPrefix calls the handler, in key order, for every key starting with the provided prefix. The values
+// written during the simulation replace the ones from the underlying storage, an empty value meaning a deleted key.
+func (sdh *simulatedDataHandler) IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return ErrNilIterationHandler
+	}
+
+	values := make(map[string][]byte)
+	if !check.IfNil(sdh.base) {
+		baseIterator, ok := sdh.base.(vmcommon.AccountDataIterator)
+		if !ok {
+			return ErrIterationNotSupported
+		}
+
+		err := baseIterator.IterateKeysWithPrefix(prefix, func(key []byte, value []byte) bool {
+			values[string(key)] = value
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	sdh.mutData.RLock()
+	for key, value := range sdh.written {
+		if bytes.HasPrefix([]byte(key), prefix) {
+			values[key] = append([]byte(nil), value...)
+		}
+	}
+	sdh.mutData.RUnlock()
+
+	keys := make([]string, 0, len(values))
+	for key, value := range values {
+		if len(value) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		shouldContinue := handler([]byte(key), values[key])
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// MigrateDataTrieLeaves does nothing, as migrating the underlying data trie would change it. The migration only changes
+// the way the values are stored, so the simulated storage is the same, but the gas consumed by the migration is not
+// charged during a simulation.
+func (sdh *simulatedDataHandler) MigrateDataTrieLeaves(_ vmcommon.ArgsMigrateDataTrieLeaves) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (sdh *simulatedDataHandler) IsInterfaceNil() bool {
+	return sdh == nil
+}
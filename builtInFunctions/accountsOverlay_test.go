@@ -0,0 +1,162 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsOverlay_WritesShouldNotReachTheBase(t *testing.T) {
+	t.Parallel()
+
+	base, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	address := []byte("address")
+	baseAcc := loadUserAccountFromAdapter(t, base, address)
+	_ = baseAcc.AddToBalance(big.NewInt(10))
+	_ = baseAcc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = base.SaveAccount(baseAcc)
+	rootHash, _ := base.RootHash()
+
+	overlay := newAccountsOverlay(base)
+	acc := loadUserAccountFromAdapter(t, overlay, address)
+	value, _, _ := acc.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+
+	_ = acc.AddToBalance(big.NewInt(5))
+	_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("new value"))
+	require.Nil(t, overlay.SaveAccount(acc))
+
+	acc = loadUserAccountFromAdapter(t, overlay, address)
+	value, _, _ = acc.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("new value"), value)
+	assert.Equal(t, big.NewInt(15), acc.GetBalance())
+
+	newRootHash, _ := base.RootHash()
+	assert.Equal(t, rootHash, newRootHash)
+	overlayRootHash, _ := overlay.RootHash()
+	assert.Equal(t, rootHash, overlayRootHash)
+	baseAcc = loadUserAccountFromAdapter(t, base, address)
+	value, _, _ = baseAcc.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+	assert.Equal(t, big.NewInt(10), baseAcc.GetBalance())
+
+	overlay.reset()
+	acc = loadUserAccountFromAdapter(t, overlay, address)
+	value, _, _ = acc.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestAccountsOverlay_RevertToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	base, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	overlay := newAccountsOverlay(base)
+	assert.Equal(t, ErrInvalidArguments, overlay.RevertToSnapshot(1))
+
+	acc := loadUserAccountFromAdapter(t, overlay, []byte("address"))
+	_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = overlay.SaveAccount(acc)
+	assert.Equal(t, 1, overlay.JournalLen())
+
+	require.Nil(t, overlay.RevertToSnapshot(0))
+	assert.Equal(t, 0, overlay.JournalLen())
+	acc = loadUserAccountFromAdapter(t, overlay, []byte("address"))
+	value, _, _ := acc.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Empty(t, value)
+}
+
+func TestAccountsOverlay_TouchedKeys(t *testing.T) {
+	t.Parallel()
+
+	base, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	overlay := newAccountsOverlay(base)
+
+	accB := loadUserAccountFromAdapter(t, overlay, []byte("b"))
+	_, _, _ = accB.AccountDataHandler().RetrieveValue([]byte("key"))
+	accA := loadUserAccountFromAdapter(t, overlay, []byte("a"))
+	_ = accA.AccountDataHandler().SaveKeyValue([]byte("key2"), []byte("value"))
+	_, _, _ = accA.AccountDataHandler().RetrieveValue([]byte("key1"))
+
+	expected := []*TouchedKey{
+		{Address: []byte("a"), Key: []byte("key1"), Read: true},
+		{Address: []byte("a"), Key: []byte("key2"), Written: true},
+		{Address: []byte("b"), Key: []byte("key"), Read: true},
+	}
+	assert.Equal(t, expected, overlay.getTouchedKeys())
+
+	overlay.reset()
+	assert.Empty(t, overlay.getTouchedKeys())
+
+	accAB := loadUserAccountFromAdapter(t, overlay, []byte("ab"))
+	_, _, _ = accAB.AccountDataHandler().RetrieveValue([]byte("c"))
+	accA = loadUserAccountFromAdapter(t, overlay, []byte("a"))
+	_ = accA.AccountDataHandler().SaveKeyValue([]byte("bc"), []byte("value"))
+
+	expected = []*TouchedKey{
+		{Address: []byte("a"), Key: []byte("bc"), Written: true},
+		{Address: []byte("ab"), Key: []byte("c"), Read: true},
+	}
+	assert.Equal(t, expected, overlay.getTouchedKeys())
+}
+
+func TestAccountsOverlay_NotSupportedOperations(t *testing.T) {
+	t.Parallel()
+
+	base, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	overlay := newAccountsOverlay(base)
+
+	_, err := overlay.Commit()
+	assert.Equal(t, ErrOperationNotSupportedInSimulation, err)
+	assert.Equal(t, ErrOperationNotSupportedInSimulation, overlay.RemoveAccount([]byte("address")))
+	assert.Equal(t, ErrNilUserAccount, overlay.SaveAccount(nil))
+}
+
+func TestAccountsOverlay_SimulatedAccountShouldNotChangeTheBaseAccount(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	baseAcc, _ := inMemoryAccounts.NewUserAccount([]byte("address"))
+	_ = baseAcc.AddToBalance(big.NewInt(10))
+	baseAcc.SetOwnerAddress(owner)
+	baseAcc.AddToDeveloperReward(big.NewInt(3))
+	baseAcc.SetUserName([]byte("name"))
+	baseAcc.SetCodeMetadata([]byte{1, 0})
+
+	overlay := newAccountsOverlay(nil)
+	acc := overlay.newSimulatedAccount(baseAcc)
+
+	acc.IncreaseNonce(2)
+	require.Nil(t, acc.AddToBalance(big.NewInt(5)))
+	require.Nil(t, acc.SubFromBalance(big.NewInt(1)))
+	assert.Equal(t, ErrInsufficientFunds, acc.SubFromBalance(big.NewInt(100)))
+	assert.Equal(t, ErrNilValue, acc.AddToBalance(nil))
+	_, err := acc.ClaimDeveloperRewards([]byte("other"))
+	assert.Equal(t, ErrOperationNotPermitted, err)
+	reward, err := acc.ClaimDeveloperRewards(owner)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(3), reward)
+	assert.Equal(t, ErrInvalidAddressLength, acc.ChangeOwnerAddress(owner, []byte("short")))
+	require.Nil(t, acc.ChangeOwnerAddress(owner, []byte("newOwnr")))
+	acc.SetUserName([]byte("new name"))
+	acc.SetCodeMetadata([]byte{5, 0})
+	require.Nil(t, acc.AccountDataHandler().MigrateDataTrieLeaves(vmcommon.ArgsMigrateDataTrieLeaves{}))
+
+	assert.Equal(t, uint64(2), acc.GetNonce())
+	assert.Equal(t, big.NewInt(14), acc.GetBalance())
+	assert.Equal(t, big.NewInt(0), acc.GetDeveloperReward())
+	assert.Equal(t, []byte("newOwnr"), acc.GetOwnerAddress())
+	assert.Equal(t, []byte("new name"), acc.GetUserName())
+	assert.Equal(t, []byte{5, 0}, acc.GetCodeMetadata())
+
+	assert.Equal(t, uint64(0), baseAcc.GetNonce())
+	assert.Equal(t, big.NewInt(10), baseAcc.GetBalance())
+	assert.Equal(t, big.NewInt(3), baseAcc.GetDeveloperReward())
+	assert.Equal(t, owner, baseAcc.GetOwnerAddress())
+	assert.Equal(t, []byte("name"), baseAcc.GetUserName())
+	assert.Equal(t, []byte{1, 0}, baseAcc.GetCodeMetadata())
+}
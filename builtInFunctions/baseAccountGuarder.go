@@ -9,6 +9,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 // BaseAccountGuarderArgs is a struct placeholder for
@@ -66,11 +67,9 @@ func (baf *baseAccountGuarder) checkBaseAccountGuarderArgs(
 	if senderIsNotReceiver {
 		return ErrOperationNotPermitted
 	}
-	if value == nil {
-		return ErrNilValue
-	}
-	if !isZero(value) {
-		return ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoValue(value)
+	if err != nil {
+		return err
 	}
 	if len(arguments) != int(expectedNoOfArgs) {
 		return fmt.Errorf("%w, expected %d, got %d ", ErrInvalidNumberOfArguments, expectedNoOfArgs, len(arguments))
@@ -81,7 +80,3 @@ func (baf *baseAccountGuarder) checkBaseAccountGuarderArgs(
 
 	return nil
 }
-
-func isZero(n *big.Int) bool {
-	return len(n.Bits()) == 0
-}
@@ -0,0 +1,91 @@
+package builtInFunctions
+
+import (
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.BlockchainDataProvider = (*dataProviderWrapper)(nil)
+var _ vmcommon.AcceptPayableChecker = (*payableCheckerWrapper)(nil)
+var _ vmcommon.BlockchainDataProvider = (*dataProviderAndPayableCheckerWrapper)(nil)
+var _ vmcommon.AcceptPayableChecker = (*dataProviderAndPayableCheckerWrapper)(nil)
+
+// withOptionalInterfaces returns the wrapper of a built-in function, implementing as well the optional interfaces of the
+// wrapped built-in function. This way, the callers type-asserting the optional interfaces, like SetBlockchainHook or
+// SetPayableHandler, still reach the wrapped built-in function.
+func withOptionalInterfaces(wrapper vmcommon.BuiltinFunction, wrapped vmcommon.BuiltinFunction) vmcommon.BuiltinFunction {
+	dataProvider, isDataProvider := wrapped.(vmcommon.BlockchainDataProvider)
+	payableChecker, isPayableChecker := wrapped.(vmcommon.AcceptPayableChecker)
+
+	switch {
+	case isDataProvider && isPayableChecker:
+		return &dataProviderAndPayableCheckerWrapper{
+			dataProviderWrapper: dataProviderWrapper{
+				BuiltinFunction: wrapper,
+				dataProvider:    dataProvider,
+			},
+			payableChecker: payableChecker,
+		}
+	case isDataProvider:
+		return &dataProviderWrapper{
+			BuiltinFunction: wrapper,
+			dataProvider:    dataProvider,
+		}
+	case isPayableChecker:
+		return &payableCheckerWrapper{
+			BuiltinFunction: wrapper,
+			payableChecker:  payableChecker,
+		}
+	default:
+		return wrapper
+	}
+}
+
+type dataProviderWrapper struct {
+	vmcommon.BuiltinFunction
+	dataProvider vmcommon.BlockchainDataProvider
+}
+
+// SetBlockchainHook sets the blockchain hook on the wrapped built-in function
+func (dpw *dataProviderWrapper) SetBlockchainHook(blockchainHook vmcommon.BlockchainDataHook) error {
+	return dpw.dataProvider.SetBlockchainHook(blockchainHook)
+}
+
+// CurrentRound returns the current round of the wrapped built-in function
+func (dpw *dataProviderWrapper) CurrentRound() uint64 {
+	return dpw.dataProvider.CurrentRound()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (dpw *dataProviderWrapper) IsInterfaceNil() bool {
+	return dpw == nil
+}
+
+type payableCheckerWrapper struct {
+	vmcommon.BuiltinFunction
+	payableChecker vmcommon.AcceptPayableChecker
+}
+
+// SetPayableChecker sets the payable checker on the wrapped built-in function
+func (pcw *payableCheckerWrapper) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
+	return pcw.payableChecker.SetPayableChecker(payableHandler)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (pcw *payableCheckerWrapper) IsInterfaceNil() bool {
+	return pcw == nil
+}
+
+type dataProviderAndPayableCheckerWrapper struct {
+	dataProviderWrapper
+	payableChecker vmcommon.AcceptPayableChecker
+}
+
+// SetPayableChecker sets the payable checker on the wrapped built-in function
+func (w *dataProviderAndPayableCheckerWrapper) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
+	return w.payableChecker.SetPayableChecker(payableHandler)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (w *dataProviderAndPayableCheckerWrapper) IsInterfaceNil() bool {
+	return w == nil
+}
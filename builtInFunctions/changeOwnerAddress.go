@@ -8,6 +8,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type changeOwnerAddress struct {
@@ -49,14 +50,13 @@ func (c *changeOwnerAddress) ProcessBuiltinFunction(
 	c.mutExecution.RLock()
 	defer c.mutExecution.RUnlock()
 
-	if vmInput == nil {
-		return nil, ErrNilVmInput
-	}
-	if len(vmInput.Arguments) == 0 {
-		return nil, ErrInvalidArguments
+	err := inputValidator.CheckMinNumArguments(vmInput, 1)
+	if err != nil {
+		return nil, err
 	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return nil, ErrBuiltInFunctionCalledWithValue
+	err = inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return nil, err
 	}
 	if len(vmInput.Arguments[0]) != len(vmInput.CallerAddr) {
 		return nil, ErrInvalidAddressLength
@@ -77,7 +77,7 @@ func (c *changeOwnerAddress) ProcessBuiltinFunction(
 		return nil, fmt.Errorf("%w not the owner of the account", ErrOperationNotPermitted)
 	}
 
-	err := acntDst.ChangeOwnerAddress(vmInput.CallerAddr, vmInput.Arguments[0])
+	err = acntDst.ChangeOwnerAddress(vmInput.CallerAddr, vmInput.Arguments[0])
 	if err != nil {
 		return nil, err
 	}
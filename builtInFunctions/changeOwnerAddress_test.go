@@ -68,6 +68,16 @@ func TestChangeOwnerAddress_ProcessBuiltinFunction(t *testing.T) {
 	_, err = coa.ProcessBuiltinFunction(nil, acc, nil)
 	require.Equal(t, ErrNilVmInput, err)
 
+	vmInput.CallValue = nil
+	_, err = coa.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Equal(t, ErrNilValue, err)
+
+	vmInput.CallValue = big.NewInt(1)
+	_, err = coa.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput.CallValue = big.NewInt(0)
+
 	_, err = coa.ProcessBuiltinFunction(nil, nil, vmInput)
 	require.Nil(t, err)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type claimDeveloperRewards struct {
@@ -40,11 +41,9 @@ func (c *claimDeveloperRewards) ProcessBuiltinFunction(
 	c.mutExecution.RLock()
 	defer c.mutExecution.RUnlock()
 
-	if vmInput == nil {
-		return nil, ErrNilVmInput
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return nil, ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return nil, err
 	}
 	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, c.gasCost)
 	if check.IfNil(acntDst) {
@@ -1,6 +1,8 @@
 package builtInFunctions
 
 import (
+	"fmt"
+
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
@@ -12,7 +14,7 @@ var _ vmcommon.BuiltInFunctionFactory = (*builtInFuncCreator)(nil)
 var trueHandler = func() bool { return true }
 var falseHandler = func() bool { return false }
 
-const deleteUserNameFuncName = "DeleteUserName" // all builtInFunction names are upper case
+const deleteUserNameFuncName = vmcommon.BuiltInFunctionDeleteUserName // all builtInFunction names are upper case
 
 // ArgsCreateBuiltInFunctionContainer defines the input arguments to create built in functions container
 type ArgsCreateBuiltInFunctionContainer struct {
@@ -27,6 +29,7 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	GuardedAccountHandler            vmcommon.GuardedAccountHandler
 	MaxNumOfAddressesForTransferRole uint32
 	ConfigAddress                    []byte
+	Tracer                           Tracer
 }
 
 type builtInFuncCreator struct {
@@ -40,10 +43,14 @@ type builtInFuncCreator struct {
 	shardCoordinator                 vmcommon.Coordinator
 	dcdtStorageHandler               vmcommon.DCDTNFTStorageHandler
 	dcdtGlobalSettingsHandler        vmcommon.DCDTGlobalSettingsHandler
+	dcdtSupplyHandler                DCDTSupplyHandler
+	dcdtPortfolioHandler             DCDTPortfolioHandler
 	enableEpochsHandler              vmcommon.EnableEpochsHandler
 	guardedAccountHandler            vmcommon.GuardedAccountHandler
 	maxNumOfAddressesForTransferRole uint32
 	configAddress                    []byte
+	tracer                           Tracer
+	isTracingEnabled                 bool
 }
 
 // NewBuiltInFunctionsCreator creates a component which will instantiate the built in functions contracts
@@ -85,6 +92,14 @@ func NewBuiltInFunctionsCreator(args ArgsCreateBuiltInFunctionContainer) (*built
 		guardedAccountHandler:            args.GuardedAccountHandler,
 		maxNumOfAddressesForTransferRole: args.MaxNumOfAddressesForTransferRole,
 		configAddress:                    args.ConfigAddress,
+		tracer:                           NewDisabledTracer(),
+	}
+
+	// the tracer is optional, the accounts and the built-in functions being wrapped only if a tracer is provided
+	if !check.IfNil(args.Tracer) {
+		b.tracer = args.Tracer
+		b.isTracingEnabled = true
+		b.accounts = newTracingAccountsAdapter(args.Accounts, args.Tracer)
 	}
 
 	b.gasConfig, err = createGasConfig(args.GasMap)
@@ -96,22 +111,39 @@ func NewBuiltInFunctionsCreator(args ArgsCreateBuiltInFunctionContainer) (*built
 	return b, nil
 }
 
-// GasScheduleChange is called when gas schedule is changed, thus all contracts must be updated
+// GasScheduleChange is called when gas schedule is changed, thus all contracts must be updated.
+// A rejected gas schedule is logged and the previous gas config is kept, use UpdateGasSchedule to get the error.
 func (b *builtInFuncCreator) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	err := b.UpdateGasSchedule(gasSchedule)
+	if err != nil {
+		log.Warn("builtInFuncCreator.GasScheduleChange: gas schedule rejected", "error", err)
+	}
+}
+
+// UpdateGasSchedule validates the gas schedule and sets it on all the built-in functions. If the gas schedule is
+// rejected, the previous gas config is kept and the error is returned.
+func (b *builtInFuncCreator) UpdateGasSchedule(gasSchedule map[string]map[string]uint64) error {
+	err := ValidateGasSchedule(gasSchedule).Error()
+	if err != nil {
+		return err
+	}
+
 	newGasConfig, err := createGasConfig(gasSchedule)
 	if err != nil {
-		return
+		return fmt.Errorf("%w: %s", ErrInvalidGasSchedule, err.Error())
 	}
 
 	b.gasConfig = newGasConfig
 	for key := range b.builtInFunctions.Keys() {
 		builtInFunc, errGet := b.builtInFunctions.Get(key)
 		if errGet != nil {
-			return
+			return errGet
 		}
 
 		builtInFunc.SetNewGasConfig(b.gasConfig)
 	}
+
+	return nil
 }
 
 // NFTStorageHandler will return the dcdt storage handler from the built in functions factory
@@ -124,8 +156,23 @@ func (b *builtInFuncCreator) DCDTGlobalSettingsHandler() vmcommon.DCDTGlobalSett
 	return b.dcdtGlobalSettingsHandler
 }
 
-// BuiltInFunctionContainer will return the built in function container
+// DCDTSupplyHandler will return the dcdt supply handler from the built in functions factory
+func (b *builtInFuncCreator) DCDTSupplyHandler() DCDTSupplyHandler {
+	return b.dcdtSupplyHandler
+}
+
+// DCDTPortfolioHandler will return the handler able to enumerate the dcdt tokens held by an account
+func (b *builtInFuncCreator) DCDTPortfolioHandler() DCDTPortfolioHandler {
+	return b.dcdtPortfolioHandler
+}
+
+// BuiltInFunctionContainer will return the built in function container. If a tracer was provided, the returned
+// built-in functions report their execution steps to it.
 func (b *builtInFuncCreator) BuiltInFunctionContainer() vmcommon.BuiltInFunctionContainer {
+	if b.isTracingEnabled {
+		return newTracingContainer(b.builtInFunctions, b.tracer)
+	}
+
 	return b.builtInFunctions
 }
 
@@ -193,6 +240,11 @@ func (b *builtInFuncCreator) CreateBuiltInFunctionContainer() error {
 	}
 	b.dcdtGlobalSettingsHandler = globalSettingsFunc
 
+	b.dcdtSupplyHandler, err = b.createDCDTSupplyHandler()
+	if err != nil {
+		return err
+	}
+
 	setRoleFunc, err := NewDCDTRolesFunc(b.marshaller, true)
 	if err != nil {
 		return err
@@ -275,10 +327,12 @@ func (b *builtInFuncCreator) CreateBuiltInFunctionContainer() error {
 		EnableEpochsHandler:   b.enableEpochsHandler,
 		ShardCoordinator:      b.shardCoordinator,
 	}
-	b.dcdtStorageHandler, err = NewDCDTDataStorage(args)
+	dcdtStorage, err := NewDCDTDataStorage(args)
 	if err != nil {
 		return err
 	}
+	b.dcdtStorageHandler = dcdtStorage
+	b.dcdtPortfolioHandler = dcdtStorage
 
 	newFunc, err = NewDCDTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.DCDTNFTAddQuantity, b.dcdtStorageHandler, globalSettingsFunc, setRoleFunc, b.enableEpochsHandler)
 	if err != nil {
@@ -605,6 +659,68 @@ func (b *builtInFuncCreator) CreateBuiltInFunctionContainer() error {
 		return err
 	}
 
+	err = b.setDCDTSupplyHandler()
+	if err != nil {
+		return err
+	}
+
+	if b.isTracingEnabled {
+		return b.setTracer()
+	}
+
+	return nil
+}
+
+// createDCDTSupplyHandler creates the supply tracking component only if the enable epochs handler defines its flag
+func (b *builtInFuncCreator) createDCDTSupplyHandler() (DCDTSupplyHandler, error) {
+	if !b.enableEpochsHandler.IsFlagDefined(DCDTSupplyTrackingFlag) {
+		return NewDisabledDCDTSupplyHandler(), nil
+	}
+
+	return NewDCDTSupplyHandler(b.accounts, b.marshaller, b.enableEpochsHandler)
+}
+
+// setDCDTSupplyHandler sets the supply handler to the built-in functions minting or burning fungible tokens
+func (b *builtInFuncCreator) setDCDTSupplyHandler() error {
+	for funcName := range b.builtInFunctions.Keys() {
+		builtInFunc, err := b.builtInFunctions.Get(funcName)
+		if err != nil {
+			return err
+		}
+
+		supplyFunc, ok := builtInFunc.(AcceptDCDTSupplyHandler)
+		if !ok {
+			continue
+		}
+
+		err = supplyFunc.SetDCDTSupplyHandler(b.dcdtSupplyHandler)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setTracer sets the tracer to the built-in functions able to report their inner steps
+func (b *builtInFuncCreator) setTracer() error {
+	for funcName := range b.builtInFunctions.Keys() {
+		builtInFunc, err := b.builtInFunctions.Get(funcName)
+		if err != nil {
+			return err
+		}
+
+		tracedFunc, ok := builtInFunc.(AcceptTracer)
+		if !ok {
+			continue
+		}
+
+		err = tracedFunc.SetTracer(b.tracer)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
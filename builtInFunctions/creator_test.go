@@ -161,6 +161,26 @@ func TestCreateBuiltInContainer_GasScheduleChange(t *testing.T) {
 	assert.Equal(t, f.gasConfig.BuiltInCost.ClaimDeveloperRewards, uint64(5))
 }
 
+func TestCreateBuiltInContainer_UpdateGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	f, _ := NewBuiltInFunctionsCreator(args)
+	_ = f.CreateBuiltInFunctionContainer()
+
+	gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 5)
+	delete(gasMap[core.BuiltInCostString], "SaveKeyValue")
+	err := f.UpdateGasSchedule(gasMap)
+	assert.True(t, errors.Is(err, ErrInvalidGasSchedule))
+	assert.Contains(t, err.Error(), "BuiltInCost.SaveKeyValue")
+	assert.Equal(t, uint64(1), f.gasConfig.BuiltInCost.ClaimDeveloperRewards)
+
+	gasMap = fillGasMapInternal(make(map[string]map[string]uint64), 5)
+	err = f.UpdateGasSchedule(gasMap)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), f.gasConfig.BuiltInCost.ClaimDeveloperRewards)
+}
+
 func TestCreateBuiltInContainer_Create(t *testing.T) {
 	args := createMockArguments()
 	f, _ := NewBuiltInFunctionsCreator(args)
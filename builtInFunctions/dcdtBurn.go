@@ -16,6 +16,7 @@ type dcdtBurn struct {
 	marshaller            vmcommon.Marshalizer
 	keyPrefix             []byte
 	globalSettingsHandler vmcommon.DCDTGlobalSettingsHandler
+	supplyHandler         DCDTSupplyHandler
 	mutExecution          sync.RWMutex
 }
 
@@ -41,6 +42,7 @@ func NewDCDTBurnFunc(
 		marshaller:            marshaller,
 		keyPrefix:             []byte(baseDCDTKeyPrefix),
 		globalSettingsHandler: globalSettingsHandler,
+		supplyHandler:         NewDisabledDCDTSupplyHandler(),
 	}
 
 	e.baseActiveHandler.activeHandler = func() bool {
@@ -98,6 +100,11 @@ func (e *dcdtBurn) ProcessBuiltinFunction(
 		return nil, err
 	}
 
+	err = e.supplyHandler.AddBurned(vmInput.Arguments[0], value)
+	if err != nil {
+		return nil, err
+	}
+
 	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, e.funcGasCost)
 	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
 	if vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
@@ -117,6 +124,16 @@ func (e *dcdtBurn) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// SetDCDTSupplyHandler will set the handler tracking the minted and burned amounts of the fungible tokens
+func (e *dcdtBurn) SetDCDTSupplyHandler(supplyHandler DCDTSupplyHandler) error {
+	if check.IfNil(supplyHandler) {
+		return ErrNilDCDTSupplyHandler
+	}
+
+	e.supplyHandler = supplyHandler
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dcdtBurn) IsInterfaceNil() bool {
 	return e == nil
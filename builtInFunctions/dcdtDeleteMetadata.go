@@ -8,6 +8,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 const numArgsPerAdd = 3
@@ -73,11 +74,9 @@ func (e *dcdtDeleteMetaData) ProcessBuiltinFunction(
 	_, _ vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
 ) (*vmcommon.VMOutput, error) {
-	if vmInput == nil {
-		return nil, ErrNilVmInput
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return nil, ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return nil, err
 	}
 	if !bytes.Equal(vmInput.CallerAddr, e.allowedAddress) {
 		return nil, ErrAddressIsNotAllowed
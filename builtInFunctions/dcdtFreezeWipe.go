@@ -7,6 +7,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type dcdtFreezeWipe struct {
@@ -14,6 +15,7 @@ type dcdtFreezeWipe struct {
 	dcdtStorageHandler  vmcommon.DCDTNFTStorageHandler
 	enableEpochsHandler vmcommon.EnableEpochsHandler
 	marshaller          vmcommon.Marshalizer
+	supplyHandler       DCDTSupplyHandler
 	keyPrefix           []byte
 	wipe                bool
 	freeze              bool
@@ -41,6 +43,7 @@ func NewDCDTFreezeWipeFunc(
 		dcdtStorageHandler:  dcdtStorageHandler,
 		enableEpochsHandler: enableEpochsHandler,
 		marshaller:          marshaller,
+		supplyHandler:       NewDisabledDCDTSupplyHandler(),
 		keyPrefix:           []byte(baseDCDTKeyPrefix),
 		freeze:              freeze,
 		wipe:                wipe,
@@ -58,14 +61,13 @@ func (e *dcdtFreezeWipe) ProcessBuiltinFunction(
 	_, acntDst vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
 ) (*vmcommon.VMOutput, error) {
-	if vmInput == nil {
-		return nil, ErrNilVmInput
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return nil, ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return nil, err
 	}
-	if len(vmInput.Arguments) != 1 {
-		return nil, ErrInvalidArguments
+	err = inputValidator.CheckNumArguments(vmInput, 1)
+	if err != nil {
+		return nil, err
 	}
 	if !bytes.Equal(vmInput.CallerAddr, core.DCDTSCAddress) {
 		return nil, ErrAddressIsNotDCDTSystemSC
@@ -78,7 +80,6 @@ func (e *dcdtFreezeWipe) ProcessBuiltinFunction(
 	identifier, nonce := extractTokenIdentifierAndNonceDCDTWipe(vmInput.Arguments[0])
 
 	var amount *big.Int
-	var err error
 
 	if e.wipe {
 		amount, err = e.wipeIfApplicable(acntDst, dcdtTokenKey, identifier, nonce)
@@ -121,6 +122,13 @@ func (e *dcdtFreezeWipe) wipeIfApplicable(acntDst vmcommon.UserAccountHandler, t
 	}
 
 	wipedAmount := vmcommon.ZeroValueIfNil(tokenData.Value)
+	if nonce == 0 {
+		err = e.supplyHandler.AddBurned(identifier, wipedAmount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return wipedAmount, nil
 }
 
@@ -152,6 +160,16 @@ func (e *dcdtFreezeWipe) toggleFreeze(acntDst vmcommon.UserAccountHandler, token
 	return frozenAmount, nil
 }
 
+// SetDCDTSupplyHandler will set the handler tracking the minted and burned amounts of the fungible tokens
+func (e *dcdtFreezeWipe) SetDCDTSupplyHandler(supplyHandler DCDTSupplyHandler) error {
+	if check.IfNil(supplyHandler) {
+		return ErrNilDCDTSupplyHandler
+	}
+
+	e.supplyHandler = supplyHandler
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dcdtFreezeWipe) IsInterfaceNil() bool {
 	return e == nil
@@ -134,9 +134,20 @@ func TestDCDTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	err = acnt.AccountDataHandler().SaveKeyValue(dcdtKey, dcdtTokenBytes)
 	assert.NoError(t, err)
 
+	burned := big.NewInt(0)
+	supplyHandler := &dcdtSupplyHandlerStub{
+		AddBurnedCalled: func(tokenID []byte, value *big.Int) error {
+			assert.Equal(t, key, tokenID)
+			burned.Add(burned, value)
+			return nil
+		},
+	}
 	wipe, _ = NewDCDTFreezeWipeFunc(createNewDCDTDataStorageHandler(), &mock.EnableEpochsHandlerStub{}, marshaller, false, true)
+	err = wipe.SetDCDTSupplyHandler(supplyHandler)
+	assert.Nil(t, err)
 	vmOutput, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
 	assert.NoError(t, err)
+	assert.Equal(t, wipedAmount, burned)
 
 	marshaledData, _, _ = acnt.AccountDataHandler().RetrieveValue(dcdtKey)
 	assert.Equal(t, 0, len(marshaledData))
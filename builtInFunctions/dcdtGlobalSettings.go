@@ -10,6 +10,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 // DCDTTypeForGlobalSettingsHandler is needed because if 0 is retrieved from the global settings handler,
@@ -91,14 +92,13 @@ func (e *dcdtGlobalSettings) ProcessBuiltinFunction(
 	_, _ vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
 ) (*vmcommon.VMOutput, error) {
-	if vmInput == nil {
-		return nil, ErrNilVmInput
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return nil, ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return nil, err
 	}
-	if len(vmInput.Arguments) != 1 {
-		return nil, ErrInvalidArguments
+	err = inputValidator.CheckNumArguments(vmInput, 1)
+	if err != nil {
+		return nil, err
 	}
 	if !bytes.Equal(vmInput.CallerAddr, core.DCDTSCAddress) {
 		return nil, ErrAddressIsNotDCDTSystemSC
@@ -109,7 +109,7 @@ func (e *dcdtGlobalSettings) ProcessBuiltinFunction(
 
 	dcdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
 
-	err := e.toggleSetting(dcdtTokenKey)
+	err = e.toggleSetting(dcdtTokenKey)
 	if err != nil {
 		return nil, err
 	}
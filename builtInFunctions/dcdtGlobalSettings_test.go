@@ -60,6 +60,9 @@ func TestDCDTGlobalSettingsPause_ProcessBuiltInFunction(t *testing.T) {
 	_, err := globalSettingsFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
+	_, err = globalSettingsFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+	assert.Equal(t, err, ErrNilValue)
+
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
 			CallValue: big.NewInt(0),
@@ -9,6 +9,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type dcdtLocalBurn struct {
@@ -18,6 +19,7 @@ type dcdtLocalBurn struct {
 	globalSettingsHandler vmcommon.ExtendedDCDTGlobalSettingsHandler
 	rolesHandler          vmcommon.DCDTRoleHandler
 	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	supplyHandler         DCDTSupplyHandler
 	funcGasCost           uint64
 	mutExecution          sync.RWMutex
 }
@@ -50,6 +52,7 @@ func NewDCDTLocalBurnFunc(
 		rolesHandler:          rolesHandler,
 		funcGasCost:           funcGasCost,
 		enableEpochsHandler:   enableEpochsHandler,
+		supplyHandler:         NewDisabledDCDTSupplyHandler(),
 		mutExecution:          sync.RWMutex{},
 	}
 
@@ -99,6 +102,11 @@ func (e *dcdtLocalBurn) ProcessBuiltinFunction(
 		return nil, err
 	}
 
+	err = e.supplyHandler.AddBurned(tokenID, value)
+	if err != nil {
+		return nil, err
+	}
+
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
 
 	addDCDTEntryInVMOutput(vmOutput, []byte(core.BuiltInFunctionDCDTLocalBurn), vmInput.Arguments[0], 0, value, vmInput.CallerAddr)
@@ -116,25 +124,28 @@ func (e *dcdtLocalBurn) isAllowedToBurn(acntSnd vmcommon.UserAccountHandler, tok
 	return e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.DCDTRoleLocalBurn))
 }
 
+// SetDCDTSupplyHandler will set the handler tracking the minted and burned amounts of the fungible tokens
+func (e *dcdtLocalBurn) SetDCDTSupplyHandler(supplyHandler DCDTSupplyHandler) error {
+	if check.IfNil(supplyHandler) {
+		return ErrNilDCDTSupplyHandler
+	}
+
+	e.supplyHandler = supplyHandler
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dcdtLocalBurn) IsInterfaceNil() bool {
 	return e == nil
 }
 
 func checkBasicDCDTArguments(vmInput *vmcommon.ContractCallInput) error {
-	if vmInput == nil {
-		return ErrNilVmInput
-	}
-	if vmInput.CallValue == nil {
-		return ErrNilValue
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return ErrBuiltInFunctionCalledWithValue
-	}
-	if len(vmInput.Arguments) < core.MinLenArgumentsDCDTTransfer {
-		return ErrInvalidArguments
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return err
 	}
-	return nil
+
+	return inputValidator.CheckMinNumArguments(vmInput, core.MinLenArgumentsDCDTTransfer)
 }
 
 func checkInputArgumentsForLocalAction(
@@ -156,9 +167,6 @@ func checkInputArgumentsForLocalAction(
 	if value.Cmp(zero) <= 0 {
 		return ErrNegativeValue
 	}
-	if vmInput.GasProvided < funcGasCost {
-		return ErrNotEnoughGas
-	}
 
-	return nil
+	return inputValidator.CheckGasProvided(vmInput, funcGasCost)
 }
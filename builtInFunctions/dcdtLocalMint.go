@@ -17,6 +17,7 @@ type dcdtLocalMint struct {
 	globalSettingsHandler vmcommon.DCDTGlobalSettingsHandler
 	rolesHandler          vmcommon.DCDTRoleHandler
 	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	supplyHandler         DCDTSupplyHandler
 	funcGasCost           uint64
 	mutExecution          sync.RWMutex
 }
@@ -49,6 +50,7 @@ func NewDCDTLocalMintFunc(
 		rolesHandler:          rolesHandler,
 		funcGasCost:           funcGasCost,
 		enableEpochsHandler:   enableEpochsHandler,
+		supplyHandler:         NewDisabledDCDTSupplyHandler(),
 		mutExecution:          sync.RWMutex{},
 	}
 
@@ -100,6 +102,11 @@ func (e *dcdtLocalMint) ProcessBuiltinFunction(
 		return nil, err
 	}
 
+	err = e.supplyHandler.AddMinted(tokenID, value)
+	if err != nil {
+		return nil, err
+	}
+
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
 
 	addDCDTEntryInVMOutput(vmOutput, []byte(core.BuiltInFunctionDCDTLocalMint), vmInput.Arguments[0], 0, value, vmInput.CallerAddr)
@@ -107,6 +114,16 @@ func (e *dcdtLocalMint) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// SetDCDTSupplyHandler will set the handler tracking the minted and burned amounts of the fungible tokens
+func (e *dcdtLocalMint) SetDCDTSupplyHandler(supplyHandler DCDTSupplyHandler) error {
+	if check.IfNil(supplyHandler) {
+		return ErrNilDCDTSupplyHandler
+	}
+
+	e.supplyHandler = supplyHandler
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dcdtLocalMint) IsInterfaceNil() bool {
 	return e == nil
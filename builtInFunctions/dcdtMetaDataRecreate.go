@@ -11,6 +11,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 const (
@@ -93,14 +94,9 @@ func checkUpdateArguments(
 	rolesHandler vmcommon.DCDTRoleHandler,
 	role string,
 ) error {
-	if vmInput == nil {
-		return ErrNilVmInput
-	}
-	if vmInput.CallValue == nil {
-		return ErrNilValue
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return err
 	}
 	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
 		return ErrInvalidRcvAddr
@@ -23,6 +23,7 @@ type dcdtNFTTransfer struct {
 	*baseComponentsHolder
 	keyPrefix      []byte
 	payableHandler vmcommon.PayableChecker
+	tracer         Tracer
 	funcGasCost    uint64
 	accounts       vmcommon.AccountsAdapter
 	gasConfig      vmcommon.BaseOperationCost
@@ -94,6 +95,16 @@ func (e *dcdtNFTTransfer) SetPayableChecker(payableHandler vmcommon.PayableCheck
 	return nil
 }
 
+// SetTracer will set the tracer which receives the balance changes of the transfers
+func (e *dcdtNFTTransfer) SetTracer(tracer Tracer) error {
+	if check.IfNil(tracer) {
+		return ErrNilTracer
+	}
+
+	e.tracer = tracer
+	return nil
+}
+
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *dcdtNFTTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	if gasCost == nil {
@@ -173,6 +184,7 @@ func (e *dcdtNFTTransfer) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	traceTransferStep(e.tracer, TransferStepCreditDestination, vmInput.CallerAddr, vmInput.RecipientAddr, tickerID, nonce, value)
 
 	// no need to consume gas on destination - sender already paid for it
 	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided}
@@ -261,6 +273,7 @@ func (e *dcdtNFTTransfer) processNFTTransferOnSenderShard(
 	if err != nil {
 		return nil, err
 	}
+	traceTransferStep(e.tracer, TransferStepDebitSender, vmInput.CallerAddr, dstAddress, tickerID, nonce, quantityToTransfer)
 
 	dcdtData.Value.Set(quantityToTransfer)
 
@@ -293,6 +306,7 @@ func (e *dcdtNFTTransfer) processNFTTransferOnSenderShard(
 		if err != nil {
 			return nil, err
 		}
+		traceTransferStep(e.tracer, TransferStepCreditDestination, vmInput.CallerAddr, dstAddress, tickerID, nonce, quantityToTransfer)
 
 		err = e.accounts.SaveAccount(userAccount)
 		if err != nil {
@@ -308,6 +322,7 @@ func (e *dcdtNFTTransfer) processNFTTransferOnSenderShard(
 		if err != nil {
 			return nil, err
 		}
+		traceTransferStep(e.tracer, TransferStepCrossShard, vmInput.CallerAddr, dstAddress, tickerID, nonce, quantityToTransfer)
 	}
 
 	tokenID := dcdtTokenKey
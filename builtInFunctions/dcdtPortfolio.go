@@ -0,0 +1,110 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+const maxNonceBytesLength = 8
+
+var _ DCDTPortfolioHandler = (*dcdtDataStorage)(nil)
+
+// DCDTHolding is a dcdt token held by an account. For the non-fungible tokens, the metadata is resolved from the
+// system account when it is not kept on the user account.
+type DCDTHolding struct {
+	TokenIdentifier []byte
+	Nonce           uint64
+	Token           *dcdt.DCDigitalToken
+}
+
+// IterateDCDTTokens calls the handler, in storage key order, for every dcdt token held by the provided account.
+// The iteration stops when the handler returns false. The account storage must implement vmcommon.AccountDataIterator.
+func (e *dcdtDataStorage) IterateDCDTTokens(accnt vmcommon.UserAccountHandler, handler func(holding *DCDTHolding) bool) error {
+	if check.IfNil(accnt) {
+		return ErrNilUserAccount
+	}
+	if handler == nil {
+		return ErrNilIterationHandler
+	}
+
+	iterator, ok := accnt.AccountDataHandler().(vmcommon.AccountDataIterator)
+	if !ok {
+		return ErrIterationNotSupported
+	}
+
+	// the holdings are collected first, as resolving them reads the account storage
+	holdings := make([]*DCDTHolding, 0)
+	err := iterator.IterateKeysWithPrefix(e.keyPrefix, func(key []byte, _ []byte) bool {
+		tokenID, nonce, isDCDTKey := splitDCDTStorageKey(key[len(e.keyPrefix):])
+		if isDCDTKey {
+			holdings = append(holdings, &DCDTHolding{
+				TokenIdentifier: tokenID,
+				Nonce:           nonce,
+			})
+		}
+
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, holding := range holdings {
+		dcdtTokenKey := append(append([]byte(nil), e.keyPrefix...), holding.TokenIdentifier...)
+		holding.Token, _, err = e.GetDCDTNFTTokenOnDestination(accnt, dcdtTokenKey, holding.Nonce)
+		if err != nil {
+			return fmt.Errorf("%w while reading token %s, nonce %d", err, holding.TokenIdentifier, holding.Nonce)
+		}
+
+		shouldContinue := handler(holding)
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// GetAllDCDTTokens returns all the dcdt tokens held by the provided account, in storage key order
+func (e *dcdtDataStorage) GetAllDCDTTokens(accnt vmcommon.UserAccountHandler) ([]*DCDTHolding, error) {
+	holdings := make([]*DCDTHolding, 0)
+	err := e.IterateDCDTTokens(accnt, func(holding *DCDTHolding) bool {
+		holdings = append(holdings, holding)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return holdings, nil
+}
+
+// splitDCDTStorageKey splits the storage key, without the dcdt prefix, in the token identifier and the nonce. The
+// token identifier is the ticker, a dash and the random sequence, while the rest of the key is the big endian nonce.
+// The first dash is used as the ticker can not contain one, while the nonce bytes can.
+func splitDCDTStorageKey(key []byte) ([]byte, uint64, bool) {
+	dashIndex := bytes.IndexByte(key, '-')
+	if dashIndex <= 0 {
+		return nil, 0, false
+	}
+
+	tokenIDLength := dashIndex + 1 + dcdtRandomSequenceLength
+	if len(key) < tokenIDLength {
+		return nil, 0, false
+	}
+
+	nonceBytes := key[tokenIDLength:]
+	if len(nonceBytes) > maxNonceBytesLength {
+		return nil, 0, false
+	}
+
+	tokenID := append([]byte(nil), key[:tokenIDLength]...)
+	nonce := big.NewInt(0).SetBytes(nonceBytes).Uint64()
+
+	return tokenID, nonce, true
+}
@@ -0,0 +1,150 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nftNonceWithDash is encoded as a single '-' byte, checking the storage key split
+const nftNonceWithDash = uint64('-')
+
+func createPortfolioAccount(t *testing.T) (*dcdtDataStorage, vmcommon.UserAccountHandler) {
+	accounts, err := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	require.Nil(t, err)
+
+	args := createMockArgsForNewDCDTDataStorage()
+	args.Accounts = accounts
+	dataStorage, err := NewDCDTDataStorage(args)
+	require.Nil(t, err)
+
+	userAcc := loadUserAccountFromAdapter(t, accounts, []byte("user"))
+	fungibleKey := []byte(baseDCDTKeyPrefix + "FNG-abcdef")
+	err = saveDCDTData(userAcc, &dcdt.DCDigitalToken{Value: big.NewInt(100)}, fungibleKey, args.Marshalizer)
+	require.Nil(t, err)
+
+	nftKey := computeDCDTNFTTokenKey([]byte(baseDCDTKeyPrefix+"NFT-123456"), nftNonceWithDash)
+	nftToken := &dcdt.DCDigitalToken{
+		Type:  uint32(core.NonFungible),
+		Value: big.NewInt(1),
+	}
+	err = saveDCDTData(userAcc, nftToken, nftKey, args.Marshalizer)
+	require.Nil(t, err)
+	_ = userAcc.AccountDataHandler().SaveKeyValue([]byte(core.ProtectedKeyPrefix+"other"), []byte("value"))
+
+	systemAcc := loadUserAccountFromAdapter(t, accounts, vmcommon.SystemAccountAddress)
+	metaData := &dcdt.DCDigitalToken{
+		TokenMetaData: &dcdt.MetaData{
+			Nonce: nftNonceWithDash,
+			Name:  []byte("nft"),
+		},
+	}
+	metaDataBytes, _ := args.Marshalizer.Marshal(metaData)
+	_ = systemAcc.AccountDataHandler().SaveKeyValue(nftKey, metaDataBytes)
+	require.Nil(t, accounts.SaveAccount(systemAcc))
+
+	return dataStorage, userAcc
+}
+
+func TestDcdtDataStorage_IterateDCDTTokens(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataStorage := createNewDCDTDataStorageHandler()
+		err := dataStorage.IterateDCDTTokens(nil, func(_ *DCDTHolding) bool { return true })
+		assert.Equal(t, ErrNilUserAccount, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataStorage := createNewDCDTDataStorageHandler()
+		err := dataStorage.IterateDCDTTokens(mock.NewUserAccount([]byte("user")), nil)
+		assert.Equal(t, ErrNilIterationHandler, err)
+	})
+	t.Run("storage without iteration support should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataStorage := createNewDCDTDataStorageHandler()
+		err := dataStorage.IterateDCDTTokens(mock.NewUserAccount([]byte("user")), func(_ *DCDTHolding) bool { return true })
+		assert.Equal(t, ErrIterationNotSupported, err)
+	})
+	t.Run("should stop when the handler returns false", func(t *testing.T) {
+		t.Parallel()
+
+		dataStorage, userAcc := createPortfolioAccount(t)
+		numCalls := 0
+		err := dataStorage.IterateDCDTTokens(userAcc, func(_ *DCDTHolding) bool {
+			numCalls++
+			return false
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
+
+func TestDcdtDataStorage_GetAllDCDTTokens(t *testing.T) {
+	t.Parallel()
+
+	dataStorage, userAcc := createPortfolioAccount(t)
+	holdings, err := dataStorage.GetAllDCDTTokens(userAcc)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(holdings))
+
+	assert.Equal(t, []byte("FNG-abcdef"), holdings[0].TokenIdentifier)
+	assert.Equal(t, uint64(0), holdings[0].Nonce)
+	assert.Equal(t, big.NewInt(100), holdings[0].Token.Value)
+	assert.Nil(t, holdings[0].Token.TokenMetaData)
+
+	assert.Equal(t, []byte("NFT-123456"), holdings[1].TokenIdentifier)
+	assert.Equal(t, nftNonceWithDash, holdings[1].Nonce)
+	assert.Equal(t, big.NewInt(1), holdings[1].Token.Value)
+	require.NotNil(t, holdings[1].Token.TokenMetaData)
+	assert.Equal(t, []byte("nft"), holdings[1].Token.TokenMetaData.Name)
+}
+
+func TestDcdtDataStorage_GetAllDCDTTokensOnSimulatedAccount(t *testing.T) {
+	t.Parallel()
+
+	dataStorage, userAcc := createPortfolioAccount(t)
+	overlay := newAccountsOverlay(dataStorage.accounts)
+	simulatedAcc := overlay.newSimulatedAccount(userAcc)
+
+	_ = simulatedAcc.AccountDataHandler().SaveKeyValue([]byte(baseDCDTKeyPrefix+"FNG-abcdef"), nil)
+	newTokenBytes, _ := dataStorage.marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(5)})
+	_ = simulatedAcc.AccountDataHandler().SaveKeyValue([]byte(baseDCDTKeyPrefix+"AAA-abcdef"), newTokenBytes)
+
+	holdings, err := dataStorage.GetAllDCDTTokens(simulatedAcc)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(holdings))
+	assert.Equal(t, []byte("AAA-abcdef"), holdings[0].TokenIdentifier)
+	assert.Equal(t, big.NewInt(5), holdings[0].Token.Value)
+	assert.Equal(t, []byte("NFT-123456"), holdings[1].TokenIdentifier)
+}
+
+func TestSplitDCDTStorageKey(t *testing.T) {
+	t.Parallel()
+
+	_, _, ok := splitDCDTStorageKey([]byte("TKN"))
+	assert.False(t, ok)
+	_, _, ok = splitDCDTStorageKey([]byte("-abcdef"))
+	assert.False(t, ok)
+	_, _, ok = splitDCDTStorageKey([]byte("TKN-abc"))
+	assert.False(t, ok)
+	_, _, ok = splitDCDTStorageKey([]byte("TKN-abcdef123456789"))
+	assert.False(t, ok)
+
+	tokenID, nonce, ok := splitDCDTStorageKey(append([]byte("TKN-abcdef"), '-', '-'))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("TKN-abcdef"), tokenID)
+	assert.Equal(t, uint64(0x2d2d), nonce)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 const (
@@ -54,14 +55,13 @@ func NewDCDTSetTokenTypeFunc(
 
 // ProcessBuiltinFunction saves the token type in the system account
 func (e *dcdtSetTokenType) ProcessBuiltinFunction(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
-	if vmInput == nil {
-		return nil, ErrNilVmInput
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return nil, ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return nil, err
 	}
-	if len(vmInput.Arguments) != 2 {
-		return nil, ErrInvalidArguments
+	err = inputValidator.CheckNumArguments(vmInput, 2)
+	if err != nil {
+		return nil, err
 	}
 	if !bytes.Equal(vmInput.CallerAddr, core.DCDTSCAddress) {
 		return nil, ErrAddressIsNotDCDTSystemSC
@@ -0,0 +1,139 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/TerraDharitri/protobuf/protobuf  --gogoslick_out=. dcdtSupply.proto
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+const supply = "supply"
+
+var supplyKeyPrefix = []byte(core.ProtectedKeyPrefix + supply + core.DCDTKeyIdentifier)
+
+// DCDTSupplyFromBytes unmarshals the supply saved on the system account. A missing value is a zero supply.
+func DCDTSupplyFromBytes(marshaller vmcommon.Marshalizer, buff []byte) (*DCDTSupply, error) {
+	dcdtSupply := &DCDTSupply{}
+	if len(buff) > 0 {
+		err := marshaller.Unmarshal(dcdtSupply, buff)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dcdtSupply.Minted = vmcommon.ZeroValueIfNil(dcdtSupply.Minted)
+	dcdtSupply.Burned = vmcommon.ZeroValueIfNil(dcdtSupply.Burned)
+
+	return dcdtSupply, nil
+}
+
+// Supply returns the circulating supply, which is the minted amount minus the burned amount
+func (s *DCDTSupply) Supply() *big.Int {
+	return big.NewInt(0).Sub(vmcommon.ZeroValueIfNil(s.Minted), vmcommon.ZeroValueIfNil(s.Burned))
+}
+
+type dcdtSupplyHandler struct {
+	accounts            vmcommon.AccountsAdapter
+	marshaller          vmcommon.Marshalizer
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+// NewDCDTSupplyHandler returns the component tracking the supply of the fungible tokens on the system account
+func NewDCDTSupplyHandler(
+	accounts vmcommon.AccountsAdapter,
+	marshaller vmcommon.Marshalizer,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+) (*dcdtSupplyHandler, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	return &dcdtSupplyHandler{
+		accounts:            accounts,
+		marshaller:          marshaller,
+		enableEpochsHandler: enableEpochsHandler,
+	}, nil
+}
+
+// AddMinted adds the value to the minted amount of the token, if the supply tracking is enabled
+func (e *dcdtSupplyHandler) AddMinted(tokenID []byte, value *big.Int) error {
+	return e.updateSupply(tokenID, func(dcdtSupply *DCDTSupply) {
+		dcdtSupply.Minted.Add(dcdtSupply.Minted, value)
+	})
+}
+
+// AddBurned adds the value to the burned amount of the token, if the supply tracking is enabled
+func (e *dcdtSupplyHandler) AddBurned(tokenID []byte, value *big.Int) error {
+	return e.updateSupply(tokenID, func(dcdtSupply *DCDTSupply) {
+		dcdtSupply.Burned.Add(dcdtSupply.Burned, value)
+	})
+}
+
+func (e *dcdtSupplyHandler) updateSupply(tokenID []byte, update func(dcdtSupply *DCDTSupply)) error {
+	if !e.enableEpochsHandler.IsFlagEnabled(DCDTSupplyTrackingFlag) {
+		return nil
+	}
+
+	systemSCAccount, err := getSystemAccount(e.accounts)
+	if err != nil {
+		return err
+	}
+
+	supplyKey := computeSupplyKey(tokenID)
+	val, _, err := systemSCAccount.AccountDataHandler().RetrieveValue(supplyKey)
+	if core.IsGetNodeFromDBError(err) {
+		return err
+	}
+
+	dcdtSupply, err := DCDTSupplyFromBytes(e.marshaller, val)
+	if err != nil {
+		return err
+	}
+	update(dcdtSupply)
+
+	marshaledData, err := e.marshaller.Marshal(dcdtSupply)
+	if err != nil {
+		return err
+	}
+
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(supplyKey, marshaledData)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+// GetSupply returns the minted and burned amounts of the token in the current shard
+func (e *dcdtSupplyHandler) GetSupply(tokenID []byte) (*DCDTSupply, error) {
+	systemSCAccount, err := getSystemAccount(e.accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _, err := systemSCAccount.AccountDataHandler().RetrieveValue(computeSupplyKey(tokenID))
+	if core.IsGetNodeFromDBError(err) {
+		return nil, err
+	}
+
+	return DCDTSupplyFromBytes(e.marshaller, val)
+}
+
+func computeSupplyKey(tokenID []byte) []byte {
+	supplyKey := make([]byte, 0, len(supplyKeyPrefix)+len(tokenID))
+	supplyKey = append(supplyKey, supplyKeyPrefix...)
+	return append(supplyKey, tokenID...)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dcdtSupplyHandler) IsInterfaceNil() bool {
+	return e == nil
+}
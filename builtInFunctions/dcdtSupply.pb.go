@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dcdtSupply.proto
+
+package builtInFunctions
+
+import (
+	fmt "fmt"
+	github_com_TerraDharitri_drt_go_chain_core_data "github.com/TerraDharitri/drt-go-chain-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DCDTSupply struct {
+	Minted *math_big.Int `protobuf:"bytes,1,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/TerraDharitri/drt-go-chain-core/data.BigIntCaster" json:"Minted"`
+	Burned *math_big.Int `protobuf:"bytes,2,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/TerraDharitri/drt-go-chain-core/data.BigIntCaster" json:"Burned"`
+}
+
+func (m *DCDTSupply) Reset()      { *m = DCDTSupply{} }
+func (*DCDTSupply) ProtoMessage() {}
+func (*DCDTSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31f7b6ab8a95538d, []int{0}
+}
+func (m *DCDTSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DCDTSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DCDTSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DCDTSupply.Merge(m, src)
+}
+func (m *DCDTSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *DCDTSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DCDTSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DCDTSupply proto.InternalMessageInfo
+
+func (m *DCDTSupply) GetMinted() *math_big.Int {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *DCDTSupply) GetBurned() *math_big.Int {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DCDTSupply)(nil), "protoBuiltInFunctions.DCDTSupply")
+}
+
+func init() { proto.RegisterFile("dcdtSupply.proto", fileDescriptor_31f7b6ab8a95538d) }
+
+var fileDescriptor_31f7b6ab8a95538d = []byte{
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x90, 0x31, 0x4b, 0xc4, 0x30,
+	0x18, 0x86, 0x13, 0x87, 0x1b, 0x8a, 0xc3, 0x71, 0x20, 0x1c, 0x0e, 0xdf, 0x89, 0x93, 0x4b, 0xdb,
+	0xc1, 0xd1, 0xad, 0x57, 0x84, 0x0a, 0x2e, 0x7a, 0x20, 0xb8, 0xa5, 0x4d, 0x4d, 0x03, 0x77, 0x49,
+	0xc9, 0x7d, 0x19, 0xdc, 0xfc, 0x09, 0xfe, 0x0c, 0xf1, 0x97, 0x38, 0x76, 0xec, 0xa4, 0x36, 0x5d,
+	0xc4, 0x41, 0xee, 0x27, 0x88, 0xe9, 0x81, 0xe2, 0x7c, 0x53, 0xf2, 0xbe, 0x7c, 0x3c, 0x0f, 0xbc,
+	0xc1, 0x98, 0x17, 0x1c, 0xaf, 0x6d, 0x5d, 0x2f, 0xef, 0xa3, 0xda, 0x68, 0xd4, 0x93, 0x03, 0xff,
+	0x24, 0x56, 0x2e, 0x31, 0x53, 0xe7, 0x56, 0x15, 0x28, 0xb5, 0x5a, 0x1f, 0x86, 0x42, 0x62, 0x65,
+	0xf3, 0xa8, 0xd0, 0xab, 0x58, 0x68, 0xa1, 0x63, 0x7f, 0x96, 0xdb, 0x3b, 0x9f, 0x7c, 0xf0, 0xbf,
+	0x81, 0x72, 0xfc, 0x45, 0x83, 0x20, 0x9d, 0xa7, 0x8b, 0x01, 0x3d, 0xd1, 0xc1, 0xe8, 0x52, 0x2a,
+	0x2c, 0xf9, 0x94, 0x1e, 0xd1, 0x93, 0xfd, 0xe4, 0xe6, 0xf3, 0x75, 0xb6, 0x6d, 0x9e, 0xdf, 0x66,
+	0xd9, 0x8a, 0x61, 0x15, 0xe7, 0x52, 0x44, 0x99, 0xc2, 0xb3, 0x3f, 0xa2, 0x45, 0x69, 0x0c, 0x4b,
+	0x2b, 0x66, 0x24, 0x1a, 0x19, 0x73, 0x83, 0xa1, 0xd0, 0x61, 0x51, 0x31, 0xa9, 0xc2, 0x42, 0x9b,
+	0x32, 0xe6, 0x0c, 0x59, 0x94, 0x48, 0x91, 0x29, 0x9c, 0xb3, 0x35, 0x96, 0xe6, 0x6a, 0x0b, 0xfd,
+	0x11, 0x26, 0xd6, 0xa8, 0x92, 0x4f, 0xf7, 0x7e, 0x85, 0x43, 0xb3, 0x63, 0xe1, 0x00, 0x4d, 0x2e,
+	0x9a, 0x0e, 0x48, 0xdb, 0x01, 0xd9, 0x74, 0x40, 0x1f, 0x1c, 0xd0, 0x27, 0x07, 0xf4, 0xc5, 0x01,
+	0x6d, 0x1c, 0xd0, 0xd6, 0x01, 0x7d, 0x77, 0x40, 0x3f, 0x1c, 0x90, 0x8d, 0x03, 0xfa, 0xd8, 0x03,
+	0x69, 0x7a, 0x20, 0x6d, 0x0f, 0xe4, 0x76, 0x9c, 0xff, 0xdb, 0x3a, 0x1f, 0xf9, 0x0d, 0x4f, 0xbf,
+	0x07, 0x00, 0x43, 0x41, 0x37, 0xdf, 0x9d, 0x01, 0x00, 0x00,
+}
+
+func (this *DCDTSupply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DCDTSupply)
+	if !ok {
+		that2, ok := that.(DCDTSupply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Minted, that1.Minted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Burned, that1.Burned) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DCDTSupply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&builtInFunctions.DCDTSupply{")
+	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDcdtSupply(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DCDTSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DCDTSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DCDTSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+		size := __caster.Size(m.Burned)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Burned, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDcdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+		size := __caster.Size(m.Minted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Minted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDcdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDcdtSupply(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDcdtSupply(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DCDTSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+		l = __caster.Size(m.Minted)
+		n += 1 + l + sovDcdtSupply(uint64(l))
+	}
+	{
+		__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+		l = __caster.Size(m.Burned)
+		n += 1 + l + sovDcdtSupply(uint64(l))
+	}
+	return n
+}
+
+func sovDcdtSupply(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDcdtSupply(x uint64) (n int) {
+	return sovDcdtSupply(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DCDTSupply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DCDTSupply{`,
+		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDcdtSupply(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DCDTSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDcdtSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DCDTSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DCDTSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDcdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDcdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDcdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Minted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDcdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDcdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDcdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_TerraDharitri_drt_go_chain_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Burned = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDcdtSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDcdtSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDcdtSupply(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDcdtSupply
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDcdtSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDcdtSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDcdtSupply
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDcdtSupply
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDcdtSupply
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDcdtSupply        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDcdtSupply          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDcdtSupply = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,289 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dcdtSupplyHandlerStub struct {
+	AddMintedCalled func(tokenID []byte, value *big.Int) error
+	AddBurnedCalled func(tokenID []byte, value *big.Int) error
+	GetSupplyCalled func(tokenID []byte) (*DCDTSupply, error)
+}
+
+func (stub *dcdtSupplyHandlerStub) AddMinted(tokenID []byte, value *big.Int) error {
+	if stub.AddMintedCalled != nil {
+		return stub.AddMintedCalled(tokenID, value)
+	}
+	return nil
+}
+
+func (stub *dcdtSupplyHandlerStub) AddBurned(tokenID []byte, value *big.Int) error {
+	if stub.AddBurnedCalled != nil {
+		return stub.AddBurnedCalled(tokenID, value)
+	}
+	return nil
+}
+
+func (stub *dcdtSupplyHandlerStub) GetSupply(tokenID []byte) (*DCDTSupply, error) {
+	if stub.GetSupplyCalled != nil {
+		return stub.GetSupplyCalled(tokenID)
+	}
+	return &DCDTSupply{Minted: big.NewInt(0), Burned: big.NewInt(0)}, nil
+}
+
+func (stub *dcdtSupplyHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestDCDTSupplyFromBytes(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	t.Run("should round trip", func(t *testing.T) {
+		t.Parallel()
+
+		dcdtSupply := &DCDTSupply{Minted: big.NewInt(1000000), Burned: big.NewInt(25)}
+		buff, err := marshaller.Marshal(dcdtSupply)
+		require.Nil(t, err)
+
+		decoded, err := DCDTSupplyFromBytes(marshaller, buff)
+		require.Nil(t, err)
+		assert.Equal(t, dcdtSupply, decoded)
+		assert.Equal(t, big.NewInt(999975), decoded.Supply())
+	})
+	t.Run("zero values should be decoded as zero", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := marshaller.Marshal(&DCDTSupply{Minted: big.NewInt(0), Burned: big.NewInt(0)})
+		require.Nil(t, err)
+
+		decoded, err := DCDTSupplyFromBytes(marshaller, buff)
+		require.Nil(t, err)
+		assert.Equal(t, &DCDTSupply{Minted: big.NewInt(0), Burned: big.NewInt(0)}, decoded)
+		assert.Equal(t, big.NewInt(0), (&DCDTSupply{}).Supply())
+	})
+	t.Run("missing value should return zero supply", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DCDTSupplyFromBytes(marshaller, nil)
+		require.Nil(t, err)
+		assert.Equal(t, &DCDTSupply{Minted: big.NewInt(0), Burned: big.NewInt(0)}, decoded)
+	})
+	t.Run("malformed value should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DCDTSupplyFromBytes(marshaller, []byte{0, 0, 0, 5, 1})
+		assert.NotNil(t, err)
+		assert.Nil(t, decoded)
+	})
+}
+
+func TestNewDCDTSupplyHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewDCDTSupplyHandler(nil, &mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{})
+		assert.Nil(t, handler)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewDCDTSupplyHandler(&mock.AccountsStub{}, nil, &mock.EnableEpochsHandlerStub{})
+		assert.Nil(t, handler)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewDCDTSupplyHandler(&mock.AccountsStub{}, &mock.MarshalizerMock{}, nil)
+		assert.Nil(t, handler)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewDCDTSupplyHandler(&mock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(handler))
+	})
+}
+
+func TestDCDTSupplyHandler_AddMintedAndBurned(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("TKN-abcdef")
+
+	t.Run("flag not enabled should not save the supply", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+		rootHashBefore, _ := accounts.RootHash()
+		handler, _ := NewDCDTSupplyHandler(accounts, &mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{})
+
+		err := handler.AddMinted(tokenID, big.NewInt(100))
+		assert.Nil(t, err)
+		err = handler.AddBurned(tokenID, big.NewInt(10))
+		assert.Nil(t, err)
+
+		rootHashAfter, _ := accounts.RootHash()
+		assert.Equal(t, rootHashBefore, rootHashAfter)
+		dcdtSupply, err := handler.GetSupply(tokenID)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0), dcdtSupply.Supply())
+	})
+	t.Run("should accumulate the minted and burned amounts", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+		handler, _ := NewDCDTSupplyHandler(accounts, &mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == DCDTSupplyTrackingFlag
+			},
+		})
+
+		_ = handler.AddMinted(tokenID, big.NewInt(100))
+		_ = handler.AddMinted(tokenID, big.NewInt(50))
+		_ = handler.AddBurned(tokenID, big.NewInt(30))
+		_ = handler.AddBurned([]byte("OTHER-abcdef"), big.NewInt(1))
+
+		dcdtSupply, err := handler.GetSupply(tokenID)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(150), dcdtSupply.Minted)
+		assert.Equal(t, big.NewInt(30), dcdtSupply.Burned)
+		assert.Equal(t, big.NewInt(120), dcdtSupply.Supply())
+	})
+	t.Run("malformed stored supply should error", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+		systemAccount := loadUserAccountFromAdapter(t, accounts, vmcommon.SystemAccountAddress)
+		_ = systemAccount.AccountDataHandler().SaveKeyValue(computeSupplyKey(tokenID), []byte("malformed"))
+		_ = accounts.SaveAccount(systemAccount)
+		handler, _ := NewDCDTSupplyHandler(accounts, &mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == DCDTSupplyTrackingFlag
+			},
+		})
+
+		err := handler.AddMinted(tokenID, big.NewInt(100))
+		assert.NotNil(t, err)
+		dcdtSupply, err := handler.GetSupply(tokenID)
+		assert.NotNil(t, err)
+		assert.Nil(t, dcdtSupply)
+	})
+}
+
+func TestDCDTSupply_TrackedByBuiltInFunctions(t *testing.T) {
+	t.Parallel()
+
+	accounts, _ := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	args := createMockArguments()
+	args.Accounts = accounts
+	args.EnableEpochsHandler = &mock.EnableEpochsHandlerStub{
+		IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+			return flag == DCDTSupplyTrackingFlag || flag == GlobalMintBurnFlag
+		},
+	}
+	factory, _ := NewBuiltInFunctionsCreator(args)
+	_ = factory.CreateBuiltInFunctionContainer()
+	_ = factory.SetPayableHandler(&mock.PayableHandlerStub{})
+	bfe, _ := NewBuiltInFunctionExecutor(ArgsNewBuiltInFunctionExecutor{
+		Accounts:               accounts,
+		ShardCoordinator:       args.ShardCoordinator,
+		BuiltInFunctionFactory: factory,
+	})
+
+	tokenID := []byte("TKN-abcdef")
+	owner := []byte("owner-address-of-32-bytes-long!!")
+	acnt := loadUserAccountFromAdapter(t, accounts, owner)
+	roles, _ := args.Marshalizer.Marshal(&dcdt.DCDTRoles{Roles: [][]byte{[]byte(core.DCDTRoleLocalMint), []byte(core.DCDTRoleLocalBurn)}})
+	_ = acnt.AccountDataHandler().SaveKeyValue(append(roleKeyPrefix, tokenID...), roles)
+	_ = accounts.SaveAccount(acnt)
+
+	execute := func(function string, recipient []byte, value int64) {
+		_, err := bfe.ExecuteBuiltInFunction(&vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  owner,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{tokenID, big.NewInt(value).Bytes()},
+			},
+			RecipientAddr: recipient,
+			Function:      function,
+		})
+		require.Nil(t, err)
+	}
+	execute(core.BuiltInFunctionDCDTLocalMint, owner, 1000)
+	execute(core.BuiltInFunctionDCDTLocalBurn, owner, 100)
+	execute(core.BuiltInFunctionDCDTBurn, core.DCDTSCAddress, 50)
+
+	dcdtSupply, err := factory.DCDTSupplyHandler().GetSupply(tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), dcdtSupply.Minted)
+	assert.Equal(t, big.NewInt(150), dcdtSupply.Burned)
+	assert.Equal(t, big.NewInt(850), dcdtSupply.Supply())
+}
+
+func TestComputeSupplyKey(t *testing.T) {
+	t.Parallel()
+
+	firstKey := computeSupplyKey([]byte("FIRST-abcdef"))
+	secondKey := computeSupplyKey([]byte("SECOND-abcdef"))
+	assert.Equal(t, string(supplyKeyPrefix)+"FIRST-abcdef", string(firstKey))
+	assert.Equal(t, string(supplyKeyPrefix)+"SECOND-abcdef", string(secondKey))
+	assert.Equal(t, core.ProtectedKeyPrefix+supply+core.DCDTKeyIdentifier, string(supplyKeyPrefix))
+}
+
+func TestDCDTSupply_SetDCDTSupplyHandler(t *testing.T) {
+	t.Parallel()
+
+	burnFunc, _ := NewDCDTBurnFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.EnableEpochsHandlerStub{})
+	localBurnFunc, _ := NewDCDTLocalBurnFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCDTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{})
+	localMintFunc, _ := NewDCDTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCDTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{})
+	wipeFunc, _ := NewDCDTFreezeWipeFunc(createNewDCDTDataStorageHandler(), &mock.EnableEpochsHandlerStub{}, &mock.MarshalizerMock{}, false, true)
+
+	for _, supplyFunc := range []AcceptDCDTSupplyHandler{burnFunc, localBurnFunc, localMintFunc, wipeFunc} {
+		err := supplyFunc.SetDCDTSupplyHandler(nil)
+		assert.Equal(t, ErrNilDCDTSupplyHandler, err)
+
+		err = supplyFunc.SetDCDTSupplyHandler(&dcdtSupplyHandlerStub{})
+		assert.Nil(t, err)
+	}
+}
+
+func TestDCDTSupply_FlagNotDefinedShouldDisableTheTracking(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.EnableEpochsHandler = &mock.EnableEpochsHandlerStub{
+		IsFlagDefinedCalled: func(flag core.EnableEpochFlag) bool {
+			return flag != DCDTSupplyTrackingFlag
+		},
+	}
+	factory, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	_, isDisabled := factory.DCDTSupplyHandler().(*disabledDCDTSupplyHandler)
+	assert.True(t, isDisabled)
+
+	burnFunc, _ := factory.builtInFunctions.Get(core.BuiltInFunctionDCDTBurn)
+	assert.Equal(t, factory.DCDTSupplyHandler(), burnFunc.(*dcdtBurn).supplyHandler)
+}
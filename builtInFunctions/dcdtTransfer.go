@@ -23,6 +23,7 @@ type dcdtTransfer struct {
 	keyPrefix             []byte
 	globalSettingsHandler vmcommon.ExtendedDCDTGlobalSettingsHandler
 	payableHandler        vmcommon.PayableChecker
+	tracer                Tracer
 	shardCoordinator      vmcommon.Coordinator
 	mutExecution          sync.RWMutex
 
@@ -132,6 +133,7 @@ func (e *dcdtTransfer) ProcessBuiltinFunction(
 		if err != nil {
 			return nil, err
 		}
+		traceTransferStep(e.tracer, TransferStepDebitSender, vmInput.CallerAddr, vmInput.RecipientAddr, tokenID, 0, value)
 	}
 
 	isSCCallAfter := e.payableHandler.DetermineIsSCCallAfter(vmInput, vmInput.RecipientAddr, core.MinLenArgumentsDCDTTransfer)
@@ -146,6 +148,7 @@ func (e *dcdtTransfer) ProcessBuiltinFunction(
 		if err != nil {
 			return nil, err
 		}
+		traceTransferStep(e.tracer, TransferStepCreditDestination, vmInput.CallerAddr, vmInput.RecipientAddr, tokenID, 0, value)
 
 		if isSCCallAfter {
 			vmOutput.GasRemaining, _ = vmcommon.SafeSubUint64(vmInput.GasProvided, e.funcGasCost)
@@ -194,6 +197,8 @@ func (e *dcdtTransfer) ProcessBuiltinFunction(
 		return vmOutput, nil
 	}
 
+	traceTransferStep(e.tracer, TransferStepCrossShard, vmInput.CallerAddr, vmInput.RecipientAddr, tokenID, 0, value)
+
 	// cross-shard DCDT transfer call through a smart contract
 	if vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
 		addOutputTransferToVMOutput(
@@ -401,6 +406,16 @@ func (e *dcdtTransfer) SetPayableChecker(payableHandler vmcommon.PayableChecker)
 	return nil
 }
 
+// SetTracer will set the tracer which receives the balance changes of the transfers
+func (e *dcdtTransfer) SetTracer(tracer Tracer) error {
+	if check.IfNil(tracer) {
+		return ErrNilTracer
+	}
+
+	e.tracer = tracer
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dcdtTransfer) IsInterfaceNil() bool {
 	return e == nil
@@ -106,6 +106,10 @@ func TestDCDTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 	}
 	_, err = transferFunc.ProcessBuiltinFunction(accSnd, nil, input)
 	assert.Equal(t, err, ErrInvalidRcvAddr)
+
+	input.CallValue = nil
+	_, err = transferFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, err, ErrNilValue)
 }
 
 func TestDCDTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
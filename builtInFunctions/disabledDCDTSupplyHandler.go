@@ -0,0 +1,34 @@
+package builtInFunctions
+
+import "math/big"
+
+var _ DCDTSupplyHandler = (*disabledDCDTSupplyHandler)(nil)
+
+// disabledDCDTSupplyHandler is the default supply handler of the mint and burn functions, which does not track the supply
+type disabledDCDTSupplyHandler struct {
+}
+
+// NewDisabledDCDTSupplyHandler creates a supply handler which does nothing
+func NewDisabledDCDTSupplyHandler() *disabledDCDTSupplyHandler {
+	return &disabledDCDTSupplyHandler{}
+}
+
+// AddMinted does nothing as this is a disabled supply handler
+func (d *disabledDCDTSupplyHandler) AddMinted(_ []byte, _ *big.Int) error {
+	return nil
+}
+
+// AddBurned does nothing as this is a disabled supply handler
+func (d *disabledDCDTSupplyHandler) AddBurned(_ []byte, _ *big.Int) error {
+	return nil
+}
+
+// GetSupply returns an empty supply as this is a disabled supply handler
+func (d *disabledDCDTSupplyHandler) GetSupply(_ []byte) (*DCDTSupply, error) {
+	return &DCDTSupply{Minted: big.NewInt(0), Burned: big.NewInt(0)}, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *disabledDCDTSupplyHandler) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,24 @@
+package builtInFunctions
+
+import vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+
+var _ OutputChecker = (*disabledOutputChecker)(nil)
+
+// disabledOutputChecker is the default output checker which accepts all the outputs
+type disabledOutputChecker struct {
+}
+
+// NewDisabledOutputChecker creates an output checker which does nothing
+func NewDisabledOutputChecker() *disabledOutputChecker {
+	return &disabledOutputChecker{}
+}
+
+// Verify returns nil as this is a disabled output checker
+func (doc *disabledOutputChecker) Verify(_ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (doc *disabledOutputChecker) IsInterfaceNil() bool {
+	return doc == nil
+}
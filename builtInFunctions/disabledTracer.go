@@ -0,0 +1,51 @@
+package builtInFunctions
+
+import vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+
+var _ Tracer = (*disabledTracer)(nil)
+
+// disabledTracer is the default tracer which ignores all the execution steps
+type disabledTracer struct {
+}
+
+// NewDisabledTracer creates a tracer which does nothing
+func NewDisabledTracer() *disabledTracer {
+	return &disabledTracer{}
+}
+
+// BeginFunction does nothing as this is a disabled tracer
+func (dt *disabledTracer) BeginFunction(_ string, _ *vmcommon.ContractCallInput) {
+}
+
+// EndFunction does nothing as this is a disabled tracer
+func (dt *disabledTracer) EndFunction(_ string, _ *vmcommon.VMOutput, _ error) {
+}
+
+// AccountLoaded does nothing as this is a disabled tracer
+func (dt *disabledTracer) AccountLoaded(_ []byte) {
+}
+
+// StorageRead does nothing as this is a disabled tracer
+func (dt *disabledTracer) StorageRead(_ []byte, _ []byte, _ []byte) {
+}
+
+// StorageWritten does nothing as this is a disabled tracer
+func (dt *disabledTracer) StorageWritten(_ []byte, _ []byte, _ []byte) {
+}
+
+// LogEmitted does nothing as this is a disabled tracer
+func (dt *disabledTracer) LogEmitted(_ *vmcommon.LogEntry) {
+}
+
+// OutputTransferCreated does nothing as this is a disabled tracer
+func (dt *disabledTracer) OutputTransferCreated(_ []byte, _ *vmcommon.OutputTransfer) {
+}
+
+// TransferStep does nothing as this is a disabled tracer
+func (dt *disabledTracer) TransferStep(_ *TransferStep) {
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (dt *disabledTracer) IsInterfaceNil() bool {
+	return dt == nil
+}
@@ -2,6 +2,8 @@ package builtInFunctions
 
 import (
 	"errors"
+
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 // ErrNilAccountsAdapter defines the error when trying to use a nil AccountsAddapter
@@ -12,7 +14,7 @@ var ErrNilAccountsAdapter = errors.New("nil AccountsAdapter")
 var ErrInsufficientFunds = errors.New("insufficient funds")
 
 // ErrNilValue signals the value is nil
-var ErrNilValue = errors.New("nil value")
+var ErrNilValue = inputValidator.ErrNilCallValue
 
 // ErrNilMarshalizer signals that an operation has been attempted to or with a nil Marshalizer implementation
 var ErrNilMarshalizer = errors.New("nil Marshalizer")
@@ -21,7 +23,7 @@ var ErrNilMarshalizer = errors.New("nil Marshalizer")
 var ErrInvalidRcvAddr = errors.New("invalid receiver address")
 
 // ErrNegativeValue signals that a negative value has been detected and it is not allowed
-var ErrNegativeValue = errors.New("negative value")
+var ErrNegativeValue = inputValidator.ErrNegativeValue
 
 // ErrNilShardCoordinator signals that an operation has been attempted to or with a nil shard coordinator
 var ErrNilShardCoordinator = errors.New("nil shard coordinator")
@@ -33,10 +35,10 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 var ErrNilSCDestAccount = errors.New("nil destination SC account")
 
 // ErrNotEnoughGas signals that not enough gas has been provided
-var ErrNotEnoughGas = errors.New("not enough gas was sent in the transaction")
+var ErrNotEnoughGas = inputValidator.ErrNotEnoughGas
 
 // ErrInvalidArguments signals that invalid arguments were given to process built-in function
-var ErrInvalidArguments = errors.New("invalid arguments to process built-in function")
+var ErrInvalidArguments = inputValidator.ErrInvalidArguments
 
 // ErrOperationNotPermitted signals that operation is not permitted
 var ErrOperationNotPermitted = errors.New("operation in account not permitted")
@@ -45,7 +47,7 @@ var ErrOperationNotPermitted = errors.New("operation in account not permitted")
 var ErrInvalidAddressLength = errors.New("invalid address length")
 
 // ErrNilVmInput signals that provided vm input is nil
-var ErrNilVmInput = errors.New("nil vm input")
+var ErrNilVmInput = inputValidator.ErrNilVMInput
 
 // ErrNilDnsAddresses signals that nil dns addresses map was provided
 var ErrNilDnsAddresses = errors.New("nil dns addresses map")
@@ -57,7 +59,7 @@ var ErrCallerIsNotTheDNSAddress = errors.New("not a dns address")
 var ErrUserNameChangeIsDisabled = errors.New("user name change is disabled")
 
 // ErrBuiltInFunctionCalledWithValue signals that builtin function was called with value that is not allowed
-var ErrBuiltInFunctionCalledWithValue = errors.New("built in function called with tx value is not allowed")
+var ErrBuiltInFunctionCalledWithValue = inputValidator.ErrCallValueNotAllowed
 
 // ErrAccountNotPayable will be sent when trying to send tokens to a non-payableCheck account
 var ErrAccountNotPayable = errors.New("sending value to non payable contract")
@@ -144,7 +146,7 @@ var ErrInvalidNonce = errors.New("invalid nonce for dcdt")
 var ErrTokenHasValidMetadata = errors.New("token has valid metadata")
 
 // ErrInvalidTokenID signals that invalid tokenID was provided
-var ErrInvalidTokenID = errors.New("invalid tokenID")
+var ErrInvalidTokenID = inputValidator.ErrInvalidTokenID
 
 // ErrNilDCDTData signals that DCDT data does not exist
 var ErrNilDCDTData = errors.New("nil dcdt data")
@@ -223,3 +225,39 @@ var ErrNilBlockchainHook = errors.New("nil blockchain hook")
 
 // ErrTypeNotSetInsideGlobalSettingsHandler signals that type is not set inside global settings handler
 var ErrTypeNotSetInsideGlobalSettingsHandler = errors.New("type not set inside global settings handler")
+
+// ErrNilBuiltInFunctionFactory signals that a nil built-in function factory has been provided
+var ErrNilBuiltInFunctionFactory = errors.New("nil built-in function factory")
+
+// ErrNilVMOutput signals that a nil vm output has been returned
+var ErrNilVMOutput = errors.New("nil vm output")
+
+// ErrOperationNotSupportedInSimulation signals that the accounts operation can not be done while simulating
+var ErrOperationNotSupportedInSimulation = errors.New("operation not supported in simulation")
+
+// ErrCannotEstimateGas signals that the gas could not be estimated as the built-in function call does not succeed
+var ErrCannotEstimateGas = errors.New("cannot estimate gas")
+
+// ErrInvalidGasSchedule signals that the gas schedule can not be used by the built-in functions
+var ErrInvalidGasSchedule = errors.New("invalid gas schedule")
+
+// ErrNilBuiltInFunctionContainer signals that a nil built-in function container has been provided
+var ErrNilBuiltInFunctionContainer = errors.New("nil built-in function container")
+
+// ErrNilMetricsSink signals that a nil metrics sink has been provided
+var ErrNilMetricsSink = errors.New("nil metrics sink")
+
+// ErrInvalidLatencyBuckets signals that the latency buckets are not strictly increasing
+var ErrInvalidLatencyBuckets = errors.New("invalid latency buckets")
+
+// ErrNilDCDTSupplyHandler signals that a nil dcdt supply handler has been provided
+var ErrNilDCDTSupplyHandler = errors.New("nil dcdt supply handler")
+
+// ErrIterationNotSupported signals that the account storage does not support iterating over its keys
+var ErrIterationNotSupported = errors.New("account storage does not support iteration")
+
+// ErrNilIterationHandler signals that a nil iteration handler has been provided
+var ErrNilIterationHandler = errors.New("nil iteration handler")
+
+// ErrNilTracer signals that a nil tracer has been provided
+var ErrNilTracer = errors.New("nil tracer")
@@ -0,0 +1,235 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// ArgsNewBuiltInFunctionExecutor defines the arguments needed to create a new built-in function executor
+type ArgsNewBuiltInFunctionExecutor struct {
+	Accounts               vmcommon.AccountsAdapter
+	ShardCoordinator       vmcommon.Coordinator
+	BuiltInFunctionFactory vmcommon.BuiltInFunctionFactory
+	OutputChecker          OutputChecker
+}
+
+type builtInFunctionExecutor struct {
+	accounts         vmcommon.AccountsAdapter
+	shardCoordinator vmcommon.Coordinator
+	factory          vmcommon.BuiltInFunctionFactory
+	outputChecker    OutputChecker
+}
+
+// NewBuiltInFunctionExecutor creates a component able to run a built-in function and apply its output on the accounts state.
+// The output checker is optional, the outputs being verified only if it is provided.
+func NewBuiltInFunctionExecutor(args ArgsNewBuiltInFunctionExecutor) (*builtInFunctionExecutor, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.BuiltInFunctionFactory) {
+		return nil, ErrNilBuiltInFunctionFactory
+	}
+
+	bfe := &builtInFunctionExecutor{
+		accounts:         args.Accounts,
+		shardCoordinator: args.ShardCoordinator,
+		factory:          args.BuiltInFunctionFactory,
+		outputChecker:    NewDisabledOutputChecker(),
+	}
+	if !check.IfNil(args.OutputChecker) {
+		bfe.outputChecker = args.OutputChecker
+	}
+
+	return bfe, nil
+}
+
+// ExecuteBuiltInFunction resolves the built-in function by the input function name, loads the sender and the
+// destination accounts if they are in the current shard, runs the function and saves the resulting state.
+// Balance deltas and storage updates from the output accounts are applied as well, except for the sender and the
+// destination accounts: the built-in function changes those in place, so their output accounts only report the changes
+// already made and are not applied a second time. On any error, or if the
+// function returns a not Ok return code, the accounts state is reverted to the snapshot taken before the execution.
+// An output breaking the invariants of the output checker is handled as an error.
+// The system account is never saved by the executor, as built-in functions persist it themselves.
+func (bfe *builtInFunctionExecutor) ExecuteBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if input == nil {
+		return nil, ErrNilVmInput
+	}
+
+	function, err := bfe.factory.BuiltInFunctionContainer().Get(input.Function)
+	if err != nil {
+		return nil, err
+	}
+	if !function.IsActive() {
+		return nil, ErrBuiltInFunctionIsNotActive
+	}
+
+	snapshot := bfe.accounts.JournalLen()
+	vmOutput, err := bfe.processAndSave(function, input)
+	if err != nil {
+		bfe.revertToSnapshot(snapshot)
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		bfe.revertToSnapshot(snapshot)
+	}
+
+	return vmOutput, nil
+}
+
+func (bfe *builtInFunctionExecutor) processAndSave(
+	function vmcommon.BuiltinFunction,
+	input *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	acntSnd, err := bfe.loadAccountIfInShard(input.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	acntDst := acntSnd
+	if !bytes.Equal(input.CallerAddr, input.RecipientAddr) {
+		acntDst, err = bfe.loadAccountIfInShard(input.RecipientAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput, err := function.ProcessBuiltinFunction(acntSnd, acntDst, input)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput == nil {
+		return nil, ErrNilVMOutput
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput, nil
+	}
+	err = bfe.outputChecker.Verify(input, vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bfe.saveAccount(acntSnd)
+	if err != nil {
+		return nil, err
+	}
+	if acntDst != acntSnd {
+		err = bfe.saveAccount(acntDst)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = bfe.applyOutputAccounts(vmOutput, acntSnd, acntDst)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+// applyOutputAccounts applies the balance deltas and the storage updates on the accounts not processed by the
+// built-in function itself
+func (bfe *builtInFunctionExecutor) applyOutputAccounts(
+	vmOutput *vmcommon.VMOutput,
+	processedAccounts ...vmcommon.UserAccountHandler,
+) error {
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if isProcessedAccount(outAcc.Address, processedAccounts) {
+			continue
+		}
+
+		hasBalanceChange := outAcc.BalanceDelta != nil && outAcc.BalanceDelta.Sign() != 0
+		if !hasBalanceChange && len(outAcc.StorageUpdates) == 0 {
+			continue
+		}
+
+		account, err := bfe.loadAccountIfInShard(outAcc.Address)
+		if err != nil {
+			return err
+		}
+		if check.IfNil(account) {
+			continue
+		}
+
+		if hasBalanceChange {
+			err = account.AddToBalance(outAcc.BalanceDelta)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, storageUpdate := range outAcc.StorageUpdates {
+			err = account.AccountDataHandler().SaveKeyValue(storageUpdate.Offset, storageUpdate.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = bfe.accounts.SaveAccount(account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isProcessedAccount(address []byte, processedAccounts []vmcommon.UserAccountHandler) bool {
+	for _, account := range processedAccounts {
+		if !check.IfNil(account) && bytes.Equal(account.AddressBytes(), address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bfe *builtInFunctionExecutor) loadAccountIfInShard(address []byte) (vmcommon.UserAccountHandler, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+	if bfe.shardCoordinator.ComputeId(address) != bfe.shardCoordinator.SelfId() {
+		return nil, nil
+	}
+
+	account, err := bfe.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func (bfe *builtInFunctionExecutor) saveAccount(account vmcommon.UserAccountHandler) error {
+	if check.IfNil(account) {
+		return nil
+	}
+	if vmcommon.IsSystemAccountAddress(account.AddressBytes()) {
+		return nil
+	}
+
+	return bfe.accounts.SaveAccount(account)
+}
+
+func (bfe *builtInFunctionExecutor) revertToSnapshot(snapshot int) {
+	err := bfe.accounts.RevertToSnapshot(snapshot)
+	if err != nil {
+		log.Warn("builtInFunctionExecutor.revertToSnapshot", "snapshot", snapshot, "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (bfe *builtInFunctionExecutor) IsInterfaceNil() bool {
+	return bfe == nil
+}
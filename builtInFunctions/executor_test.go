@@ -0,0 +1,305 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createExecutorArgs(t *testing.T) ArgsNewBuiltInFunctionExecutor {
+	accounts, err := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	require.Nil(t, err)
+
+	args := createMockArguments()
+	args.Accounts = accounts
+	factory, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+	err = factory.SetPayableHandler(&mock.PayableHandlerStub{})
+	require.Nil(t, err)
+
+	return ArgsNewBuiltInFunctionExecutor{
+		Accounts:               accounts,
+		ShardCoordinator:       args.ShardCoordinator,
+		BuiltInFunctionFactory: factory,
+	}
+}
+
+func loadUserAccountFromAdapter(t *testing.T, accounts vmcommon.AccountsAdapter, address []byte) vmcommon.UserAccountHandler {
+	account, err := accounts.LoadAccount(address)
+	require.Nil(t, err)
+
+	return account.(vmcommon.UserAccountHandler)
+}
+
+func TestNewBuiltInFunctionExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		args.Accounts = nil
+		bfe, err := NewBuiltInFunctionExecutor(args)
+		assert.Nil(t, bfe)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		args.ShardCoordinator = nil
+		bfe, err := NewBuiltInFunctionExecutor(args)
+		assert.Nil(t, bfe)
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil built-in function factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		args.BuiltInFunctionFactory = nil
+		bfe, err := NewBuiltInFunctionExecutor(args)
+		assert.Nil(t, bfe)
+		assert.Equal(t, ErrNilBuiltInFunctionFactory, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bfe, err := NewBuiltInFunctionExecutor(createExecutorArgs(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(bfe))
+	})
+}
+
+func TestBuiltInFunctionExecutor_ExecuteBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil input should error", func(t *testing.T) {
+		t.Parallel()
+
+		bfe, _ := NewBuiltInFunctionExecutor(createExecutorArgs(t))
+		vmOutput, err := bfe.ExecuteBuiltInFunction(nil)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, ErrNilVmInput, err)
+	})
+	t.Run("unknown function should error", func(t *testing.T) {
+		t.Parallel()
+
+		bfe, _ := NewBuiltInFunctionExecutor(createExecutorArgs(t))
+		vmOutput, err := bfe.ExecuteBuiltInFunction(&vmcommon.ContractCallInput{Function: "unknown"})
+		assert.Nil(t, vmOutput)
+		assert.True(t, errors.Is(err, ErrInvalidContainerKey))
+	})
+	t.Run("inactive function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		_ = args.BuiltInFunctionFactory.BuiltInFunctionContainer().Add("inactive", &mock.BuiltInFunctionStub{
+			IsActiveCalled: func() bool {
+				return false
+			},
+		})
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		vmOutput, err := bfe.ExecuteBuiltInFunction(&vmcommon.ContractCallInput{Function: "inactive"})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, ErrBuiltInFunctionIsNotActive, err)
+	})
+	t.Run("save key value should persist the storage", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		address := []byte("user-address-of-32-bytes-long-00")
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  address,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{[]byte("key"), []byte("value")},
+			},
+			RecipientAddr: address,
+			Function:      core.BuiltInFunctionSaveKeyValue,
+		}
+
+		vmOutput, err := bfe.ExecuteBuiltInFunction(input)
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+		value, _, _ := loadUserAccountFromAdapter(t, args.Accounts, address).AccountDataHandler().RetrieveValue([]byte("key"))
+		assert.Equal(t, []byte("value"), value)
+	})
+	t.Run("dcdt transfer should move the tokens", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		marshaller := &mock.MarshalizerMock{}
+		tokenKey := []byte(baseDCDTKeyPrefix + "TKN-abcdef")
+		sender := []byte("sender-address-of-32-bytes-long!")
+		receiver := []byte("receiver-address-of-32-bytes-lon")
+
+		acntSnd := loadUserAccountFromAdapter(t, args.Accounts, sender)
+		balance, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(100)})
+		_ = acntSnd.AccountDataHandler().SaveKeyValue(tokenKey, balance)
+		_ = args.Accounts.SaveAccount(acntSnd)
+
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  sender,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(30).Bytes()},
+			},
+			RecipientAddr: receiver,
+			Function:      core.BuiltInFunctionDCDTTransfer,
+		}
+		_, err := bfe.ExecuteBuiltInFunction(input)
+		require.Nil(t, err)
+
+		value, _, _ := loadUserAccountFromAdapter(t, args.Accounts, receiver).AccountDataHandler().RetrieveValue(tokenKey)
+		dcdtData := &dcdt.DCDigitalToken{}
+		_ = marshaller.Unmarshal(dcdtData, value)
+		assert.Equal(t, big.NewInt(30), dcdtData.Value)
+	})
+	t.Run("output accounts should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		_ = args.BuiltInFunctionFactory.BuiltInFunctionContainer().Add("output", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						"other": {
+							Address:      []byte("other"),
+							BalanceDelta: big.NewInt(7),
+							StorageUpdates: map[string]*vmcommon.StorageUpdate{
+								"key": {Offset: []byte("key"), Data: []byte("value")},
+							},
+						},
+					},
+				}, nil
+			},
+		})
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		_, err := bfe.ExecuteBuiltInFunction(&vmcommon.ContractCallInput{Function: "output"})
+		require.Nil(t, err)
+
+		acnt := loadUserAccountFromAdapter(t, args.Accounts, []byte("other"))
+		assert.Equal(t, big.NewInt(7), acnt.GetBalance())
+		value, _, _ := acnt.AccountDataHandler().RetrieveValue([]byte("key"))
+		assert.Equal(t, []byte("value"), value)
+	})
+	t.Run("balance delta of the sender should be applied once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		sender := []byte("sender-address-of-32-bytes-long!")
+		_ = args.BuiltInFunctionFactory.BuiltInFunctionContainer().Add("delta", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				_ = acntSnd.AddToBalance(big.NewInt(10))
+
+				return &vmcommon.VMOutput{
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						string(sender): {
+							Address:      sender,
+							BalanceDelta: big.NewInt(10),
+						},
+					},
+				}, nil
+			},
+		})
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput:       vmcommon.VMInput{CallerAddr: sender},
+			RecipientAddr: sender,
+			Function:      "delta",
+		}
+		_, err := bfe.ExecuteBuiltInFunction(input)
+		require.Nil(t, err)
+
+		acnt := loadUserAccountFromAdapter(t, args.Accounts, sender)
+		assert.Equal(t, big.NewInt(10), acnt.GetBalance())
+	})
+	t.Run("error should revert the state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		address := []byte("address")
+		expectedErr := errors.New("expected error")
+		_ = args.BuiltInFunctionFactory.BuiltInFunctionContainer().Add("failing", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				otherAcnt := loadUserAccountFromAdapter(t, args.Accounts, []byte("other"))
+				_ = otherAcnt.AddToBalance(big.NewInt(10))
+				_ = args.Accounts.SaveAccount(otherAcnt)
+
+				return nil, expectedErr
+			},
+		})
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput:       vmcommon.VMInput{CallerAddr: address},
+			RecipientAddr: address,
+			Function:      "failing",
+		}
+		vmOutput, err := bfe.ExecuteBuiltInFunction(input)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, args.Accounts.JournalLen())
+		_, err = args.Accounts.GetExistingAccount([]byte("other"))
+		assert.Equal(t, inMemoryAccounts.ErrAccountNotFound, err)
+	})
+	t.Run("output checker error should revert the state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		expectedErr := errors.New("expected error")
+		checkedOutputs := 0
+		args.OutputChecker = &mock.OutputCheckerStub{
+			VerifyCalled: func(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error {
+				checkedOutputs++
+				return expectedErr
+			},
+		}
+		_ = args.BuiltInFunctionFactory.BuiltInFunctionContainer().Add("invalid", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				_ = args.Accounts.SaveAccount(loadUserAccountFromAdapter(t, args.Accounts, []byte("other")))
+
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			},
+		})
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		vmOutput, err := bfe.ExecuteBuiltInFunction(&vmcommon.ContractCallInput{Function: "invalid"})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, checkedOutputs)
+		assert.Equal(t, 0, args.Accounts.JournalLen())
+	})
+	t.Run("not ok return code should revert the state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createExecutorArgs(t)
+		_ = args.BuiltInFunctionFactory.BuiltInFunctionContainer().Add("userError", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				_ = args.Accounts.SaveAccount(loadUserAccountFromAdapter(t, args.Accounts, []byte("other")))
+
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			},
+		})
+		bfe, _ := NewBuiltInFunctionExecutor(args)
+		vmOutput, err := bfe.ExecuteBuiltInFunction(&vmcommon.ContractCallInput{Function: "userError"})
+		assert.Nil(t, err)
+		assert.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+		assert.Equal(t, 0, args.Accounts.JournalLen())
+	})
+}
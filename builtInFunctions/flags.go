@@ -30,6 +30,10 @@ const (
 	REWAInDCDTMultiTransferFlag                 core.EnableEpochFlag = "REWAInDCDTMultiTransferFlag"
 )
 
+// DCDTSupplyTrackingFlag is optional, so it is not part of allFlags: the supply of the fungible tokens is tracked only
+// if the enable epochs handler defines it
+const DCDTSupplyTrackingFlag core.EnableEpochFlag = "DCDTSupplyTrackingFlag"
+
 // allFlags must have all flags used by drt-go-chain-vm-common in the current version
 var allFlags = []core.EnableEpochFlag{
 	GlobalMintBurnFlag,
@@ -0,0 +1,181 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// GasScheduleValidationResult holds the problems found in the gas schedule sections used by the built-in functions.
+// Every key is formatted as section.name, the lists being sorted.
+type GasScheduleValidationResult struct {
+	MissingKeys []string
+	UnknownKeys []string
+	ZeroKeys    []string
+}
+
+// IsValid returns true if the gas schedule can be used by the built-in functions. Unknown keys do not invalidate the
+// gas schedule, as they can be used by other components.
+func (result *GasScheduleValidationResult) IsValid() bool {
+	return len(result.MissingKeys) == 0 && len(result.ZeroKeys) == 0
+}
+
+// Error returns the error describing the missing and zero keys, or nil if the gas schedule is valid
+func (result *GasScheduleValidationResult) Error() error {
+	if result.IsValid() {
+		return nil
+	}
+
+	return fmt.Errorf("%w, missing keys: [%s], zero keys: [%s]", ErrInvalidGasSchedule,
+		strings.Join(result.MissingKeys, ", "), strings.Join(result.ZeroKeys, ", "))
+}
+
+// GasCostChange is a gas cost that differs between two gas schedules
+type GasCostChange struct {
+	Section  string
+	Key      string
+	OldValue uint64
+	NewValue uint64
+}
+
+// GasScheduleDiff holds the differences between two gas schedules, each list being sorted by section and key
+type GasScheduleDiff struct {
+	Added   []*GasCostChange
+	Removed []*GasCostChange
+	Changed []*GasCostChange
+}
+
+// IsEmpty returns true if the gas schedules are identical
+func (diff *GasScheduleDiff) IsEmpty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0
+}
+
+// ValidateGasSchedule checks the BaseOperationCost and BuiltInCost sections of the gas schedule against the gas
+// costs used by the built-in functions, reporting the missing, unknown and zero keys
+func ValidateGasSchedule(gasSchedule map[string]map[string]uint64) *GasScheduleValidationResult {
+	result := &GasScheduleValidationResult{
+		MissingKeys: make([]string, 0),
+		UnknownKeys: make([]string, 0),
+		ZeroKeys:    make([]string, 0),
+	}
+
+	validateGasScheduleSection(core.BaseOperationCostString, gasSchedule[core.BaseOperationCostString], vmcommon.BaseOperationCost{}, result)
+	validateGasScheduleSection(core.BuiltInCostString, gasSchedule[core.BuiltInCostString], vmcommon.BuiltInCost{}, result)
+
+	sort.Strings(result.MissingKeys)
+	sort.Strings(result.UnknownKeys)
+	sort.Strings(result.ZeroKeys)
+
+	return result
+}
+
+func validateGasScheduleSection(
+	section string,
+	costs map[string]uint64,
+	expected interface{},
+	result *GasScheduleValidationResult,
+) {
+	// keys are matched case-insensitively, as done when decoding the gas schedule
+	providedKeys := make(map[string]string, len(costs))
+	for key := range costs {
+		providedKeys[strings.ToLower(key)] = key
+	}
+
+	expectedKeys := getGasCostKeys(expected)
+	for lowerKey, key := range expectedKeys {
+		providedKey, found := providedKeys[lowerKey]
+		if !found {
+			result.MissingKeys = append(result.MissingKeys, formatGasCostKey(section, key))
+			continue
+		}
+		if costs[providedKey] == 0 {
+			result.ZeroKeys = append(result.ZeroKeys, formatGasCostKey(section, key))
+		}
+	}
+
+	for lowerKey, key := range providedKeys {
+		_, found := expectedKeys[lowerKey]
+		if !found {
+			result.UnknownKeys = append(result.UnknownKeys, formatGasCostKey(section, key))
+		}
+	}
+}
+
+// getGasCostKeys returns the field names of the gas costs structure, indexed by their lower case form
+func getGasCostKeys(gasCosts interface{}) map[string]string {
+	costsType := reflect.TypeOf(gasCosts)
+	keys := make(map[string]string, costsType.NumField())
+	for i := 0; i < costsType.NumField(); i++ {
+		name := costsType.Field(i).Name
+		keys[strings.ToLower(name)] = name
+	}
+
+	return keys
+}
+
+func formatGasCostKey(section string, key string) string {
+	return section + "." + key
+}
+
+// DiffGasSchedules returns the gas costs added, removed or changed in the new gas schedule, across all sections
+func DiffGasSchedules(oldGasSchedule map[string]map[string]uint64, newGasSchedule map[string]map[string]uint64) *GasScheduleDiff {
+	diff := &GasScheduleDiff{
+		Added:   make([]*GasCostChange, 0),
+		Removed: make([]*GasCostChange, 0),
+		Changed: make([]*GasCostChange, 0),
+	}
+
+	for section, newCosts := range newGasSchedule {
+		oldCosts := oldGasSchedule[section]
+		for key, newValue := range newCosts {
+			oldValue, found := oldCosts[key]
+			change := &GasCostChange{
+				Section:  section,
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			}
+			if !found {
+				diff.Added = append(diff.Added, change)
+				continue
+			}
+			if oldValue != newValue {
+				diff.Changed = append(diff.Changed, change)
+			}
+		}
+	}
+
+	for section, oldCosts := range oldGasSchedule {
+		newCosts := newGasSchedule[section]
+		for key, oldValue := range oldCosts {
+			_, found := newCosts[key]
+			if !found {
+				diff.Removed = append(diff.Removed, &GasCostChange{
+					Section:  section,
+					Key:      key,
+					OldValue: oldValue,
+				})
+			}
+		}
+	}
+
+	sortGasCostChanges(diff.Added)
+	sortGasCostChanges(diff.Removed)
+	sortGasCostChanges(diff.Changed)
+
+	return diff
+}
+
+func sortGasCostChanges(changes []*GasCostChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Section != changes[j].Section {
+			return changes[i].Section < changes[j].Section
+		}
+
+		return changes[i].Key < changes[j].Key
+	})
+}
@@ -0,0 +1,107 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("complete gas schedule should be valid", func(t *testing.T) {
+		t.Parallel()
+
+		gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 1)
+		result := ValidateGasSchedule(gasMap)
+		assert.True(t, result.IsValid())
+		assert.Nil(t, result.Error())
+		assert.Empty(t, result.MissingKeys)
+		assert.Empty(t, result.ZeroKeys)
+	})
+	t.Run("missing, unknown and zero keys should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 1)
+		delete(gasMap[core.BaseOperationCostString], "StorePerByte")
+		delete(gasMap[core.BuiltInCostString], "DCDTTransfer")
+		gasMap[core.BuiltInCostString]["SaveKeyValue"] = 0
+		gasMap[core.BuiltInCostString]["UnknownFunction"] = 10
+		gasMap["OtherSection"] = map[string]uint64{"Other": 1}
+
+		result := ValidateGasSchedule(gasMap)
+		assert.False(t, result.IsValid())
+		assert.Equal(t, []string{"BaseOperationCost.StorePerByte", "BuiltInCost.DCDTTransfer"}, result.MissingKeys)
+		assert.Contains(t, result.UnknownKeys, "BuiltInCost.UnknownFunction")
+		assert.NotContains(t, result.UnknownKeys, "OtherSection.Other")
+		assert.Equal(t, []string{"BuiltInCost.SaveKeyValue"}, result.ZeroKeys)
+
+		err := result.Error()
+		assert.True(t, errors.Is(err, ErrInvalidGasSchedule))
+		assert.Contains(t, err.Error(), "BuiltInCost.DCDTTransfer")
+		assert.Contains(t, err.Error(), "BuiltInCost.SaveKeyValue")
+	})
+	t.Run("only unknown keys should be valid", func(t *testing.T) {
+		t.Parallel()
+
+		gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 1)
+		gasMap[core.BuiltInCostString]["UnknownFunction"] = 10
+
+		result := ValidateGasSchedule(gasMap)
+		assert.True(t, result.IsValid())
+		assert.Contains(t, result.UnknownKeys, "BuiltInCost.UnknownFunction")
+	})
+	t.Run("keys should match case-insensitively", func(t *testing.T) {
+		t.Parallel()
+
+		gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 1)
+		delete(gasMap[core.BuiltInCostString], "DCDTTransfer")
+		gasMap[core.BuiltInCostString]["dcdttransfer"] = 1
+
+		result := ValidateGasSchedule(gasMap)
+		assert.True(t, result.IsValid())
+		assert.NotContains(t, result.UnknownKeys, "BuiltInCost.dcdttransfer")
+	})
+}
+
+func TestDiffGasSchedules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identical gas schedules should return empty diff", func(t *testing.T) {
+		t.Parallel()
+
+		diff := DiffGasSchedules(
+			fillGasMapInternal(make(map[string]map[string]uint64), 1),
+			fillGasMapInternal(make(map[string]map[string]uint64), 1),
+		)
+		assert.True(t, diff.IsEmpty())
+	})
+	t.Run("should report added, removed and changed keys", func(t *testing.T) {
+		t.Parallel()
+
+		oldGasMap := map[string]map[string]uint64{
+			core.BuiltInCostString: {"B": 1, "A": 2, "C": 3},
+			"Removed":              {"X": 4},
+		}
+		newGasMap := map[string]map[string]uint64{
+			core.BuiltInCostString: {"A": 2, "B": 5, "D": 6},
+			"Added":                {"Y": 7},
+		}
+
+		diff := DiffGasSchedules(oldGasMap, newGasMap)
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, []*GasCostChange{
+			{Section: "Added", Key: "Y", NewValue: 7},
+			{Section: core.BuiltInCostString, Key: "D", NewValue: 6},
+		}, diff.Added)
+		assert.Equal(t, []*GasCostChange{
+			{Section: core.BuiltInCostString, Key: "C", OldValue: 3},
+			{Section: "Removed", Key: "X", OldValue: 4},
+		}, diff.Removed)
+		assert.Equal(t, []*GasCostChange{
+			{Section: core.BuiltInCostString, Key: "B", OldValue: 1, NewValue: 5},
+		}, diff.Changed)
+	})
+}
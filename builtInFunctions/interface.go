@@ -1 +1,59 @@
 package builtInFunctions
+
+import (
+	"math/big"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// MetricsSink receives the metrics of every built-in function execution
+type MetricsSink interface {
+	RecordExecution(record *ExecutionRecord)
+	IsInterfaceNil() bool
+}
+
+// Tracer receives the steps of the built-in function executions
+type Tracer interface {
+	BeginFunction(functionName string, input *vmcommon.ContractCallInput)
+	EndFunction(functionName string, output *vmcommon.VMOutput, err error)
+	AccountLoaded(address []byte)
+	StorageRead(address []byte, key []byte, value []byte)
+	StorageWritten(address []byte, key []byte, value []byte)
+	LogEmitted(entry *vmcommon.LogEntry)
+	OutputTransferCreated(recipient []byte, transfer *vmcommon.OutputTransfer)
+	TransferStep(step *TransferStep)
+	IsInterfaceNil() bool
+}
+
+// AcceptTracer defines the built-in functions able to report their inner steps to a tracer
+type AcceptTracer interface {
+	SetTracer(tracer Tracer) error
+	IsInterfaceNil() bool
+}
+
+// OutputChecker verifies the invariants of the outputs produced by the built-in functions
+type OutputChecker interface {
+	Verify(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error
+	IsInterfaceNil() bool
+}
+
+// DCDTSupplyHandler defines the component tracking the minted and burned amounts of the fungible tokens
+type DCDTSupplyHandler interface {
+	AddMinted(tokenID []byte, value *big.Int) error
+	AddBurned(tokenID []byte, value *big.Int) error
+	GetSupply(tokenID []byte) (*DCDTSupply, error)
+	IsInterfaceNil() bool
+}
+
+// AcceptDCDTSupplyHandler defines the built-in functions changing the supply of the fungible tokens
+type AcceptDCDTSupplyHandler interface {
+	SetDCDTSupplyHandler(supplyHandler DCDTSupplyHandler) error
+	IsInterfaceNil() bool
+}
+
+// DCDTPortfolioHandler defines the component able to enumerate the dcdt tokens held by an account
+type DCDTPortfolioHandler interface {
+	IterateDCDTTokens(accnt vmcommon.UserAccountHandler, handler func(holding *DCDTHolding) bool) error
+	GetAllDCDTTokens(accnt vmcommon.UserAccountHandler) ([]*DCDTHolding, error)
+	IsInterfaceNil() bool
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type saveKeyValueStorage struct {
@@ -127,17 +128,16 @@ func (k *saveKeyValueStorage) subtractGasFromVMoutput(vmOutput *vmcommon.VMOutpu
 }
 
 func checkArgumentsForSaveKeyValue(acntDst vmcommon.UserAccountHandler, input *vmcommon.ContractCallInput) error {
-	if input == nil {
-		return ErrNilVmInput
-	}
-	if len(input.Arguments) < 2 {
-		return ErrInvalidArguments
+	err := inputValidator.CheckMinNumArguments(input, 2)
+	if err != nil {
+		return err
 	}
 	if len(input.Arguments)%2 != 0 {
 		return ErrInvalidArguments
 	}
-	if input.CallValue.Cmp(zero) != 0 {
-		return ErrBuiltInFunctionCalledWithValue
+	err = inputValidator.CheckNoCallValue(input)
+	if err != nil {
+		return err
 	}
 	if check.IfNil(acntDst) {
 		return ErrNilSCDestAccount
@@ -110,6 +110,15 @@ func TestSaveKeyValue_ProcessBuiltinFunction(t *testing.T) {
 	value := []byte("value")
 	vmInput.Arguments = [][]byte{key, value}
 
+	vmInput.CallValue = nil
+	_, err = skv.ProcessBuiltinFunction(acc, acc, vmInput)
+	require.Equal(t, ErrNilValue, err)
+
+	vmInput.CallValue = big.NewInt(1)
+	_, err = skv.ProcessBuiltinFunction(acc, acc, vmInput)
+	require.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput.CallValue = big.NewInt(0)
 	_, err = skv.ProcessBuiltinFunction(acc, nil, vmInput)
 	require.Equal(t, ErrNilSCDestAccount, err)
 
@@ -0,0 +1,124 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var _ MetricsSink = (*metricsCollector)(nil)
+
+// FunctionMetrics holds the aggregated metrics of a built-in function
+type FunctionMetrics struct {
+	NumCalls     uint64
+	NumErrors    uint64
+	TotalGasUsed uint64
+	// ErrorCounts holds the number of failed executions by root error, which is the sentinel error for the errors
+	// wrapped from the package errors
+	ErrorCounts map[string]uint64
+	// LatencyHistogram holds the number of executions for every latency bucket, the last one counting the executions
+	// slower than the largest bucket bound
+	LatencyHistogram []uint64
+}
+
+type metricsCollector struct {
+	mutMetrics     sync.RWMutex
+	latencyBuckets []time.Duration
+	metrics        map[string]*FunctionMetrics
+}
+
+// NewMetricsCollector creates an in-memory metrics sink aggregating the executions per built-in function.
+// The latency buckets are the upper bounds of the histogram buckets and must be strictly increasing.
+func NewMetricsCollector(latencyBuckets []time.Duration) (*metricsCollector, error) {
+	for i := 1; i < len(latencyBuckets); i++ {
+		if latencyBuckets[i] <= latencyBuckets[i-1] {
+			return nil, ErrInvalidLatencyBuckets
+		}
+	}
+
+	return &metricsCollector{
+		latencyBuckets: append([]time.Duration(nil), latencyBuckets...),
+		metrics:        make(map[string]*FunctionMetrics),
+	}, nil
+}
+
+// RecordExecution aggregates the execution record in the metrics of its built-in function
+func (mc *metricsCollector) RecordExecution(record *ExecutionRecord) {
+	if record == nil {
+		return
+	}
+
+	mc.mutMetrics.Lock()
+	defer mc.mutMetrics.Unlock()
+
+	functionMetrics, found := mc.metrics[record.FunctionName]
+	if !found {
+		functionMetrics = &FunctionMetrics{
+			ErrorCounts:      make(map[string]uint64),
+			LatencyHistogram: make([]uint64, len(mc.latencyBuckets)+1),
+		}
+		mc.metrics[record.FunctionName] = functionMetrics
+	}
+
+	functionMetrics.NumCalls++
+	functionMetrics.TotalGasUsed += record.GasUsed
+	functionMetrics.LatencyHistogram[mc.getLatencyBucket(record.Duration)]++
+	if record.Err != nil {
+		functionMetrics.NumErrors++
+		functionMetrics.ErrorCounts[getRootError(record.Err).Error()]++
+	}
+}
+
+func (mc *metricsCollector) getLatencyBucket(duration time.Duration) int {
+	for i, bound := range mc.latencyBuckets {
+		if duration <= bound {
+			return i
+		}
+	}
+
+	return len(mc.latencyBuckets)
+}
+
+func getRootError(err error) error {
+	for {
+		wrappedErr := errors.Unwrap(err)
+		if wrappedErr == nil {
+			return err
+		}
+		err = wrappedErr
+	}
+}
+
+// GetMetrics returns a copy of the aggregated metrics, indexed by built-in function name
+func (mc *metricsCollector) GetMetrics() map[string]*FunctionMetrics {
+	mc.mutMetrics.RLock()
+	defer mc.mutMetrics.RUnlock()
+
+	metrics := make(map[string]*FunctionMetrics, len(mc.metrics))
+	for name, functionMetrics := range mc.metrics {
+		errorCounts := make(map[string]uint64, len(functionMetrics.ErrorCounts))
+		for key, count := range functionMetrics.ErrorCounts {
+			errorCounts[key] = count
+		}
+
+		metrics[name] = &FunctionMetrics{
+			NumCalls:         functionMetrics.NumCalls,
+			NumErrors:        functionMetrics.NumErrors,
+			TotalGasUsed:     functionMetrics.TotalGasUsed,
+			ErrorCounts:      errorCounts,
+			LatencyHistogram: append([]uint64(nil), functionMetrics.LatencyHistogram...),
+		}
+	}
+
+	return metrics
+}
+
+// LatencyBuckets returns the upper bounds of the latency histogram buckets
+func (mc *metricsCollector) LatencyBuckets() []time.Duration {
+	return append([]time.Duration(nil), mc.latencyBuckets...)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (mc *metricsCollector) IsInterfaceNil() bool {
+	return mc == nil
+}
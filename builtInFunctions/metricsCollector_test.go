@@ -0,0 +1,65 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMetricsCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not increasing latency buckets should error", func(t *testing.T) {
+		t.Parallel()
+
+		mc, err := NewMetricsCollector([]time.Duration{time.Millisecond, time.Millisecond})
+		assert.Nil(t, mc)
+		assert.Equal(t, ErrInvalidLatencyBuckets, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		buckets := []time.Duration{time.Millisecond, time.Second}
+		mc, err := NewMetricsCollector(buckets)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(mc))
+		assert.Equal(t, buckets, mc.LatencyBuckets())
+	})
+}
+
+func TestMetricsCollector_RecordExecution(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := NewMetricsCollector([]time.Duration{time.Millisecond, time.Second})
+	mc.RecordExecution(nil)
+	mc.RecordExecution(&ExecutionRecord{FunctionName: "a", GasUsed: 10, Duration: time.Microsecond})
+	mc.RecordExecution(&ExecutionRecord{FunctionName: "a", GasUsed: 5, Duration: time.Millisecond * 10})
+	mc.RecordExecution(&ExecutionRecord{FunctionName: "a", Duration: time.Minute, Err: fmt.Errorf("%w: details", ErrInvalidArguments)})
+	mc.RecordExecution(&ExecutionRecord{FunctionName: "a", Err: ErrInvalidArguments})
+	mc.RecordExecution(&ExecutionRecord{FunctionName: "b", Err: errors.New("other error")})
+
+	metrics := mc.GetMetrics()
+	assert.Equal(t, 2, len(metrics))
+	assert.Equal(t, &FunctionMetrics{
+		NumCalls:         4,
+		NumErrors:        2,
+		TotalGasUsed:     15,
+		ErrorCounts:      map[string]uint64{ErrInvalidArguments.Error(): 2},
+		LatencyHistogram: []uint64{2, 1, 1},
+	}, metrics["a"])
+	assert.Equal(t, &FunctionMetrics{
+		NumCalls:         1,
+		NumErrors:        1,
+		ErrorCounts:      map[string]uint64{"other error": 1},
+		LatencyHistogram: []uint64{1, 0, 0},
+	}, metrics["b"])
+
+	metrics["a"].ErrorCounts["changed"] = 1
+	metrics["a"].LatencyHistogram[0] = 100
+	assert.Equal(t, map[string]uint64{ErrInvalidArguments.Error(): 2}, mc.GetMetrics()["a"].ErrorCounts)
+	assert.Equal(t, uint64(2), mc.GetMetrics()["a"].LatencyHistogram[0])
+}
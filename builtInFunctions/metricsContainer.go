@@ -0,0 +1,101 @@
+package builtInFunctions
+
+import (
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.BuiltInFunctionContainer = (*metricsContainer)(nil)
+var _ vmcommon.BuiltinFunction = (*metricsBuiltInFunction)(nil)
+
+// ExecutionRecord holds the metrics of one built-in function execution
+type ExecutionRecord struct {
+	FunctionName string
+	GasProvided  uint64
+	GasUsed      uint64 // the gas forwarded through output transfers is not included
+	Duration     time.Duration
+	Err          error
+}
+
+// metricsContainer wraps a built-in functions container, so that every built-in function returned by Get reports
+// its executions to the metrics sink
+type metricsContainer struct {
+	vmcommon.BuiltInFunctionContainer
+	sink MetricsSink
+}
+
+// NewBuiltInFunctionContainerWithMetrics creates a container which instruments the built-in functions of the
+// provided container. The built-in functions added to the container are stored unchanged.
+func NewBuiltInFunctionContainerWithMetrics(container vmcommon.BuiltInFunctionContainer, sink MetricsSink) (*metricsContainer, error) {
+	if check.IfNil(container) {
+		return nil, ErrNilBuiltInFunctionContainer
+	}
+	if check.IfNil(sink) {
+		return nil, ErrNilMetricsSink
+	}
+
+	return &metricsContainer{
+		BuiltInFunctionContainer: container,
+		sink:                     sink,
+	}, nil
+}
+
+// Get returns the instrumented built-in function stored at the provided key. The instrumented built-in function
+// implements the same optional interfaces as the stored one.
+func (mc *metricsContainer) Get(key string) (vmcommon.BuiltinFunction, error) {
+	builtInFunc, err := mc.BuiltInFunctionContainer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	instrumented := &metricsBuiltInFunction{
+		BuiltinFunction: builtInFunc,
+		name:            key,
+		sink:            mc.sink,
+	}
+
+	return withOptionalInterfaces(instrumented, builtInFunc), nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (mc *metricsContainer) IsInterfaceNil() bool {
+	return mc == nil
+}
+
+type metricsBuiltInFunction struct {
+	vmcommon.BuiltinFunction
+	name string
+	sink MetricsSink
+}
+
+// ProcessBuiltinFunction executes the wrapped built-in function and records the execution metrics
+func (mbf *metricsBuiltInFunction) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	start := time.Now()
+	vmOutput, err := mbf.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	duration := time.Since(start)
+
+	record := &ExecutionRecord{
+		FunctionName: mbf.name,
+		Duration:     duration,
+		Err:          err,
+	}
+	if vmInput != nil {
+		record.GasProvided = vmInput.GasProvided
+	}
+	if err == nil && vmOutput != nil {
+		record.GasUsed, _ = computeGasUsed(record.GasProvided, vmOutput)
+	}
+	mbf.sink.RecordExecution(record)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (mbf *metricsBuiltInFunction) IsInterfaceNil() bool {
+	return mbf == nil
+}
@@ -0,0 +1,222 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type metricsSinkStub struct {
+	records []*ExecutionRecord
+}
+
+func (stub *metricsSinkStub) RecordExecution(record *ExecutionRecord) {
+	stub.records = append(stub.records, record)
+}
+
+func (stub *metricsSinkStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// payableFunctionStub is a built-in function implementing both the BlockchainDataProvider and the
+// AcceptPayableChecker optional interfaces
+type payableFunctionStub struct {
+	mock.BuiltInFunctionStub
+	SetPayableCheckerCalled func(payableHandler vmcommon.PayableChecker) error
+}
+
+func (stub *payableFunctionStub) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
+	if stub.SetPayableCheckerCalled != nil {
+		return stub.SetPayableCheckerCalled(payableHandler)
+	}
+	return nil
+}
+
+func (stub *payableFunctionStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewBuiltInFunctionContainerWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil container should error", func(t *testing.T) {
+		t.Parallel()
+
+		mc, err := NewBuiltInFunctionContainerWithMetrics(nil, &metricsSinkStub{})
+		assert.Nil(t, mc)
+		assert.Equal(t, ErrNilBuiltInFunctionContainer, err)
+	})
+	t.Run("nil sink should error", func(t *testing.T) {
+		t.Parallel()
+
+		mc, err := NewBuiltInFunctionContainerWithMetrics(NewBuiltInFunctionContainer(), nil)
+		assert.Nil(t, mc)
+		assert.Equal(t, ErrNilMetricsSink, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mc, err := NewBuiltInFunctionContainerWithMetrics(NewBuiltInFunctionContainer(), &metricsSinkStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(mc))
+	})
+}
+
+func TestMetricsContainer_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing function should error", func(t *testing.T) {
+		t.Parallel()
+
+		mc, _ := NewBuiltInFunctionContainerWithMetrics(NewBuiltInFunctionContainer(), &metricsSinkStub{})
+		builtInFunc, err := mc.Get("missing")
+		assert.Nil(t, builtInFunc)
+		assert.True(t, errors.Is(err, ErrInvalidContainerKey))
+	})
+	t.Run("should record successful and failed executions", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := fmt.Errorf("%w for token", ErrInvalidArguments)
+		returnErr := false
+		container := NewBuiltInFunctionContainer()
+		_ = container.Add("function", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				if returnErr {
+					return nil, expectedErr
+				}
+				return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - 10}, nil
+			},
+		})
+		sink := &metricsSinkStub{}
+		mc, _ := NewBuiltInFunctionContainerWithMetrics(container, sink)
+		assert.Equal(t, 1, mc.Len())
+
+		builtInFunc, err := mc.Get("function")
+		require.Nil(t, err)
+		input := &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{GasProvided: 100}}
+		_, err = builtInFunc.ProcessBuiltinFunction(nil, nil, input)
+		assert.Nil(t, err)
+
+		returnErr = true
+		_, err = builtInFunc.ProcessBuiltinFunction(nil, nil, input)
+		assert.Equal(t, expectedErr, err)
+
+		require.Equal(t, 2, len(sink.records))
+		assert.Equal(t, "function", sink.records[0].FunctionName)
+		assert.Equal(t, uint64(100), sink.records[0].GasProvided)
+		assert.Equal(t, uint64(10), sink.records[0].GasUsed)
+		assert.Nil(t, sink.records[0].Err)
+		assert.Equal(t, uint64(0), sink.records[1].GasUsed)
+		assert.Equal(t, expectedErr, sink.records[1].Err)
+	})
+	t.Run("forwarded gas should not be counted as used", func(t *testing.T) {
+		t.Parallel()
+
+		container := NewBuiltInFunctionContainer()
+		_ = container.Add("function", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						"address": {OutputTransfers: []vmcommon.OutputTransfer{{GasLimit: 60}}},
+					},
+				}, nil
+			},
+		})
+		sink := &metricsSinkStub{}
+		mc, _ := NewBuiltInFunctionContainerWithMetrics(container, sink)
+
+		builtInFunc, _ := mc.Get("function")
+		_, _ = builtInFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{GasProvided: 100}})
+		require.Equal(t, 1, len(sink.records))
+		assert.Equal(t, uint64(40), sink.records[0].GasUsed)
+	})
+}
+
+func TestMetricsContainer_OptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("function without optional interfaces should not implement them", func(t *testing.T) {
+		t.Parallel()
+
+		container := NewBuiltInFunctionContainer()
+		_ = container.Add("function", NewClaimDeveloperRewardsFunc(1))
+		mc, _ := NewBuiltInFunctionContainerWithMetrics(container, &metricsSinkStub{})
+
+		builtInFunc, err := mc.Get("function")
+		require.Nil(t, err)
+		_, isDataProvider := builtInFunc.(vmcommon.BlockchainDataProvider)
+		assert.False(t, isDataProvider)
+		_, isPayableChecker := builtInFunc.(vmcommon.AcceptPayableChecker)
+		assert.False(t, isPayableChecker)
+	})
+	t.Run("optional interfaces should reach the wrapped function", func(t *testing.T) {
+		t.Parallel()
+
+		setHookCalled := false
+		setPayableCheckerCalled := false
+		container := NewBuiltInFunctionContainer()
+		_ = container.Add("function", &payableFunctionStub{
+			BuiltInFunctionStub: mock.BuiltInFunctionStub{
+				SetBlockchainHookCalled: func(_ vmcommon.BlockchainDataHook) error {
+					setHookCalled = true
+					return nil
+				},
+				CurrentRoundCalled: func() uint64 {
+					return 7
+				},
+			},
+			SetPayableCheckerCalled: func(_ vmcommon.PayableChecker) error {
+				setPayableCheckerCalled = true
+				return nil
+			},
+		})
+		sink := &metricsSinkStub{}
+		mc, _ := NewBuiltInFunctionContainerWithMetrics(container, sink)
+
+		builtInFunc, err := mc.Get("function")
+		require.Nil(t, err)
+
+		dataProvider, ok := builtInFunc.(vmcommon.BlockchainDataProvider)
+		require.True(t, ok)
+		assert.Nil(t, dataProvider.SetBlockchainHook(&disabledBlockchainHook{}))
+		assert.True(t, setHookCalled)
+		assert.Equal(t, uint64(7), dataProvider.CurrentRound())
+
+		payableChecker, ok := builtInFunc.(vmcommon.AcceptPayableChecker)
+		require.True(t, ok)
+		assert.Nil(t, payableChecker.SetPayableChecker(&payableCheck{}))
+		assert.True(t, setPayableCheckerCalled)
+
+		_, _ = builtInFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+		assert.Equal(t, 1, len(sink.records))
+	})
+	t.Run("transfer functions should accept the payable checker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		factory, _ := NewBuiltInFunctionsCreator(args)
+		require.Nil(t, factory.CreateBuiltInFunctionContainer())
+		mc, _ := NewBuiltInFunctionContainerWithMetrics(factory.BuiltInFunctionContainer(), &metricsSinkStub{})
+		checker, _ := NewPayableCheckFunc(&mock.PayableHandlerStub{}, args.EnableEpochsHandler)
+
+		for _, name := range []string{
+			core.BuiltInFunctionDCDTTransfer,
+			core.BuiltInFunctionDCDTNFTTransfer,
+			core.BuiltInFunctionMultiDCDTNFTTransfer,
+		} {
+			builtInFunc, err := mc.Get(name)
+			require.Nil(t, err)
+
+			payableChecker, ok := builtInFunc.(vmcommon.AcceptPayableChecker)
+			require.True(t, ok, name)
+			assert.Nil(t, payableChecker.SetPayableChecker(checker), name)
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 	"github.com/TerraDharitri/drt-go-chain-vm-common/dataTrieMigrator"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type migrateDataTrie struct {
@@ -156,8 +157,9 @@ func checkArgumentsForMigrateDataTrie(
 	if input.GasProvided < cost.TrieLoadPerNode+cost.TrieStorePerNode {
 		return fmt.Errorf("not enough gas, gas provided: %d, trie load cost: %d, trie store cost: %d", input.GasProvided, cost.TrieLoadPerNode, cost.TrieStorePerNode)
 	}
-	if input.CallValue.Cmp(zero) != 0 {
-		return ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(input)
+	if err != nil {
+		return err
 	}
 	if len(input.Arguments) != 0 {
 		return fmt.Errorf("no arguments must be given to migrate data trie: %w", ErrInvalidNumberOfArguments)
@@ -96,6 +96,20 @@ func TestMigrateDataTrie_ProcessBuiltinFunction(t *testing.T) {
 		assert.Nil(t, vmOutput)
 		assert.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
 	})
+	t.Run("nil call value", func(t *testing.T) {
+		t.Parallel()
+
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue: nil,
+			},
+		}
+
+		mdtf, _ := NewMigrateDataTrieFunc(vmcommon.BuiltInCost{}, &mock.EnableEpochsHandlerStub{}, &mock.AccountsStub{})
+		vmOutput, err := mdtf.ProcessBuiltinFunction(mock.NewUserAccount([]byte("sender")), mock.NewUserAccount([]byte("dest")), input)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, ErrNilValue, err)
+	})
 	t.Run("invalid number of arguments", func(t *testing.T) {
 		t.Parallel()
 
@@ -17,6 +17,7 @@ type dcdtNFTMultiTransfer struct {
 	*baseComponentsHolder
 	keyPrefix      []byte
 	payableHandler vmcommon.PayableChecker
+	tracer         Tracer
 	funcGasCost    uint64
 	accounts       vmcommon.AccountsAdapter
 	gasConfig      vmcommon.BaseOperationCost
@@ -96,6 +97,16 @@ func (e *dcdtNFTMultiTransfer) SetPayableChecker(payableHandler vmcommon.Payable
 	return nil
 }
 
+// SetTracer will set the tracer which receives the balance changes of the transfers
+func (e *dcdtNFTMultiTransfer) SetTracer(tracer Tracer) error {
+	if check.IfNil(tracer) {
+		return ErrNilTracer
+	}
+
+	e.tracer = tracer
+	return nil
+}
+
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *dcdtNFTMultiTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	if gasCost == nil {
@@ -198,6 +209,7 @@ func (e *dcdtNFTMultiTransfer) ProcessBuiltinFunction(
 			if err != nil {
 				return nil, fmt.Errorf("%w for token %s", err, string(tokenID))
 			}
+			traceTransferStep(e.tracer, TransferStepCreditDestination, vmInput.CallerAddr, vmInput.RecipientAddr, tokenID, nonce, value)
 		} else {
 			transferredValue := big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2])
 			value.Set(transferredValue)
@@ -211,6 +223,7 @@ func (e *dcdtNFTMultiTransfer) ProcessBuiltinFunction(
 			if err != nil {
 				return nil, fmt.Errorf("%w for token %s", err, string(tokenID))
 			}
+			traceTransferStep(e.tracer, TransferStepCreditDestination, vmInput.CallerAddr, vmInput.RecipientAddr, tokenID, nonce, value)
 		}
 
 		if e.enableEpochsHandler.IsFlagEnabled(ScToScLogEventFlag) {
@@ -388,6 +401,7 @@ func (e *dcdtNFTMultiTransfer) processDCDTNFTMultiTransferOnSenderShard(
 func (e *dcdtNFTMultiTransfer) transferBaseToken(
 	acntSnd vmcommon.UserAccountHandler,
 	acntDst vmcommon.UserAccountHandler,
+	dstAddress []byte,
 	transferData *vmcommon.DCDTTransfer,
 ) (*dcdt.DCDigitalToken, error) {
 	if !e.enableEpochsHandler.IsFlagEnabled(REWAInDCDTMultiTransferFlag) {
@@ -405,6 +419,7 @@ func (e *dcdtNFTMultiTransfer) transferBaseToken(
 		if err != nil {
 			return nil, err
 		}
+		e.traceTransferStep(TransferStepDebitSender, acntSnd, dstAddress, transferData)
 	}
 
 	if !check.IfNil(acntDst) {
@@ -412,6 +427,9 @@ func (e *dcdtNFTMultiTransfer) transferBaseToken(
 		if err != nil {
 			return nil, err
 		}
+		e.traceTransferStep(TransferStepCreditDestination, acntSnd, dstAddress, transferData)
+	} else {
+		e.traceTransferStep(TransferStepCrossShard, acntSnd, dstAddress, transferData)
 	}
 
 	baseDCDTData := &dcdt.DCDigitalToken{
@@ -433,7 +451,7 @@ func (e *dcdtNFTMultiTransfer) transferOneTokenOnSenderShard(
 	}
 
 	if bytes.Equal(transferData.DCDTTokenName, e.baseTokenID) {
-		return e.transferBaseToken(acntSnd, acntDst, transferData)
+		return e.transferBaseToken(acntSnd, acntDst, dstAddress, transferData)
 	}
 
 	dcdtTokenKey := append(e.keyPrefix, transferData.DCDTTokenName...)
@@ -456,6 +474,7 @@ func (e *dcdtNFTMultiTransfer) transferOneTokenOnSenderShard(
 	if err != nil {
 		return nil, err
 	}
+	e.traceTransferStep(TransferStepDebitSender, acntSnd, dstAddress, transferData)
 
 	dcdtData.Value.Set(transferData.DCDTValue)
 
@@ -482,6 +501,7 @@ func (e *dcdtNFTMultiTransfer) transferOneTokenOnSenderShard(
 		if err != nil {
 			return nil, err
 		}
+		e.traceTransferStep(TransferStepCreditDestination, acntSnd, dstAddress, transferData)
 	} else {
 		keepMetadataOnZeroLiquidity, err := shouldKeepMetaDataOnZeroLiquidity(acntSnd, transferData.DCDTTokenName, dcdtData.Type, e.marshaller, e.enableEpochsHandler)
 		if err != nil {
@@ -492,11 +512,29 @@ func (e *dcdtNFTMultiTransfer) transferOneTokenOnSenderShard(
 		if err != nil {
 			return nil, err
 		}
+		e.traceTransferStep(TransferStepCrossShard, acntSnd, dstAddress, transferData)
 	}
 
 	return dcdtData, nil
 }
 
+func (e *dcdtNFTMultiTransfer) traceTransferStep(
+	stepType TransferStepType,
+	acntSnd vmcommon.UserAccountHandler,
+	dstAddress []byte,
+	transferData *vmcommon.DCDTTransfer,
+) {
+	if check.IfNil(e.tracer) {
+		return
+	}
+
+	var sender []byte
+	if !check.IfNil(acntSnd) {
+		sender = acntSnd.AddressBytes()
+	}
+	traceTransferStep(e.tracer, stepType, sender, dstAddress, transferData.DCDTTokenName, transferData.DCDTTokenNonce, transferData.DCDTValue)
+}
+
 func computeInsufficientQuantityDCDTError(tokenID []byte, nonce uint64) error {
 	err := fmt.Errorf("%w for token: %s", ErrInsufficientQuantityDCDT, string(tokenID))
 	if nonce > 0 {
@@ -0,0 +1,210 @@
+package builtInFunctions
+
+import (
+	"encoding/json"
+	"sync"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ Tracer = (*recordingTracer)(nil)
+
+// TraceEventType defines the type of traced execution step
+type TraceEventType string
+
+const (
+	// TraceAccountLoaded is the type of the event recorded when an account is loaded
+	TraceAccountLoaded TraceEventType = "accountLoaded"
+	// TraceStorageRead is the type of the event recorded when an account storage key is read
+	TraceStorageRead TraceEventType = "storageRead"
+	// TraceStorageWritten is the type of the event recorded when an account storage key is written
+	TraceStorageWritten TraceEventType = "storageWritten"
+	// TraceLogEmitted is the type of the event recorded for every log of the output
+	TraceLogEmitted TraceEventType = "logEmitted"
+	// TraceOutputTransferCreated is the type of the event recorded for every output transfer of the output
+	TraceOutputTransferCreated TraceEventType = "outputTransferCreated"
+	// TraceTransferStep is the type of the event recorded for every balance change of the transfer functions
+	TraceTransferStep TraceEventType = "transferStep"
+)
+
+// TraceEvent is an execution step recorded during a built-in function call
+type TraceEvent struct {
+	Type     TraceEventType           `json:"type"`
+	Address  []byte                   `json:"address,omitempty"`
+	Key      []byte                   `json:"key,omitempty"`
+	Value    []byte                   `json:"value,omitempty"`
+	Log      *vmcommon.LogEntry       `json:"log,omitempty"`
+	Transfer *vmcommon.OutputTransfer `json:"transfer,omitempty"`
+	Step     *TransferStep            `json:"step,omitempty"`
+}
+
+// CallTrace holds the execution steps of a built-in function call, in the order they happened
+type CallTrace struct {
+	FunctionName string        `json:"functionName"`
+	Caller       []byte        `json:"caller,omitempty"`
+	Recipient    []byte        `json:"recipient,omitempty"`
+	Arguments    [][]byte      `json:"arguments,omitempty"`
+	GasProvided  uint64        `json:"gasProvided"`
+	Events       []*TraceEvent `json:"events"`
+	ReturnCode   string        `json:"returnCode,omitempty"`
+	GasRemaining uint64        `json:"gasRemaining"`
+	Error        string        `json:"error,omitempty"`
+}
+
+type recordingTracer struct {
+	mutTraces    sync.Mutex
+	activeTraces []*CallTrace
+	traces       []*CallTrace
+}
+
+// NewRecordingTracer creates a tracer which records a structured trace for every built-in function call
+func NewRecordingTracer() *recordingTracer {
+	return &recordingTracer{
+		activeTraces: make([]*CallTrace, 0),
+		traces:       make([]*CallTrace, 0),
+	}
+}
+
+// BeginFunction starts the trace of a new built-in function call
+func (rt *recordingTracer) BeginFunction(functionName string, input *vmcommon.ContractCallInput) {
+	trace := &CallTrace{
+		FunctionName: functionName,
+		Events:       make([]*TraceEvent, 0),
+	}
+	if input != nil {
+		trace.Caller = copyBytes(input.CallerAddr)
+		trace.Recipient = copyBytes(input.RecipientAddr)
+		trace.GasProvided = input.GasProvided
+		for _, arg := range input.Arguments {
+			trace.Arguments = append(trace.Arguments, copyBytes(arg))
+		}
+	}
+
+	rt.mutTraces.Lock()
+	rt.activeTraces = append(rt.activeTraces, trace)
+	rt.mutTraces.Unlock()
+}
+
+// EndFunction completes the trace of the current built-in function call
+func (rt *recordingTracer) EndFunction(_ string, output *vmcommon.VMOutput, err error) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	numActiveTraces := len(rt.activeTraces)
+	if numActiveTraces == 0 {
+		return
+	}
+
+	trace := rt.activeTraces[numActiveTraces-1]
+	rt.activeTraces = rt.activeTraces[:numActiveTraces-1]
+	if output != nil {
+		trace.ReturnCode = output.ReturnCode.String()
+		trace.GasRemaining = output.GasRemaining
+	}
+	if err != nil {
+		trace.Error = err.Error()
+	}
+	rt.traces = append(rt.traces, trace)
+}
+
+// AccountLoaded records the account load in the current trace
+func (rt *recordingTracer) AccountLoaded(address []byte) {
+	rt.addEvent(&TraceEvent{
+		Type:    TraceAccountLoaded,
+		Address: copyBytes(address),
+	})
+}
+
+// StorageRead records the storage read in the current trace
+func (rt *recordingTracer) StorageRead(address []byte, key []byte, value []byte) {
+	rt.addEvent(&TraceEvent{
+		Type:    TraceStorageRead,
+		Address: copyBytes(address),
+		Key:     copyBytes(key),
+		Value:   copyBytes(value),
+	})
+}
+
+// StorageWritten records the storage write in the current trace
+func (rt *recordingTracer) StorageWritten(address []byte, key []byte, value []byte) {
+	rt.addEvent(&TraceEvent{
+		Type:    TraceStorageWritten,
+		Address: copyBytes(address),
+		Key:     copyBytes(key),
+		Value:   copyBytes(value),
+	})
+}
+
+// LogEmitted records the log entry in the current trace
+func (rt *recordingTracer) LogEmitted(entry *vmcommon.LogEntry) {
+	rt.addEvent(&TraceEvent{
+		Type: TraceLogEmitted,
+		Log:  entry,
+	})
+}
+
+// OutputTransferCreated records the output transfer in the current trace
+func (rt *recordingTracer) OutputTransferCreated(recipient []byte, transfer *vmcommon.OutputTransfer) {
+	rt.addEvent(&TraceEvent{
+		Type:     TraceOutputTransferCreated,
+		Address:  copyBytes(recipient),
+		Transfer: transfer,
+	})
+}
+
+// TransferStep records the transfer step in the current trace
+func (rt *recordingTracer) TransferStep(step *TransferStep) {
+	rt.addEvent(&TraceEvent{
+		Type: TraceTransferStep,
+		Step: step,
+	})
+}
+
+// addEvent appends the event to the current trace. Events happening outside a built-in function call are ignored.
+func (rt *recordingTracer) addEvent(event *TraceEvent) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	numActiveTraces := len(rt.activeTraces)
+	if numActiveTraces == 0 {
+		return
+	}
+
+	trace := rt.activeTraces[numActiveTraces-1]
+	trace.Events = append(trace.Events, event)
+}
+
+// Traces returns the traces of the completed built-in function calls
+func (rt *recordingTracer) Traces() []*CallTrace {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	return append([]*CallTrace(nil), rt.traces...)
+}
+
+// Dump returns the json encoded traces of the completed built-in function calls
+func (rt *recordingTracer) Dump() ([]byte, error) {
+	return json.MarshalIndent(rt.Traces(), "", "  ")
+}
+
+// Reset discards all the recorded traces
+func (rt *recordingTracer) Reset() {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	rt.activeTraces = make([]*CallTrace, 0)
+	rt.traces = make([]*CallTrace, 0)
+}
+
+func copyBytes(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+
+	return append([]byte{}, data...)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (rt *recordingTracer) IsInterfaceNil() bool {
+	return rt == nil
+}
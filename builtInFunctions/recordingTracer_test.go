@@ -0,0 +1,99 @@
+package builtInFunctions
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordingTracer(t *testing.T) {
+	t.Parallel()
+
+	rt := NewRecordingTracer()
+	assert.False(t, check.IfNil(rt))
+	assert.Empty(t, rt.Traces())
+}
+
+func TestRecordingTracer_Traces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("events outside a call should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		rt := NewRecordingTracer()
+		rt.AccountLoaded([]byte("address"))
+		rt.EndFunction("function", nil, nil)
+		assert.Empty(t, rt.Traces())
+	})
+	t.Run("should record the events of nested calls in separate traces", func(t *testing.T) {
+		t.Parallel()
+
+		rt := NewRecordingTracer()
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  []byte("caller"),
+				Arguments:   [][]byte{[]byte("arg")},
+				GasProvided: 100,
+			},
+			RecipientAddr: []byte("recipient"),
+		}
+		entry := &vmcommon.LogEntry{Identifier: []byte("identifier")}
+		transfer := &vmcommon.OutputTransfer{Value: big.NewInt(1)}
+		step := &TransferStep{Type: TransferStepDebitSender, Sender: []byte("caller"), Value: big.NewInt(1)}
+
+		rt.BeginFunction("outer", input)
+		rt.AccountLoaded([]byte("caller"))
+		rt.BeginFunction("inner", nil)
+		rt.StorageRead([]byte("caller"), []byte("key"), []byte("value"))
+		rt.EndFunction("inner", nil, errors.New("inner error"))
+		rt.StorageWritten([]byte("caller"), []byte("key"), []byte("new value"))
+		rt.LogEmitted(entry)
+		rt.OutputTransferCreated([]byte("recipient"), transfer)
+		rt.TransferStep(step)
+		rt.EndFunction("outer", &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 90}, nil)
+
+		traces := rt.Traces()
+		require.Equal(t, 2, len(traces))
+		assert.Equal(t, &CallTrace{
+			FunctionName: "inner",
+			Events: []*TraceEvent{
+				{Type: TraceStorageRead, Address: []byte("caller"), Key: []byte("key"), Value: []byte("value")},
+			},
+			Error: "inner error",
+		}, traces[0])
+		assert.Equal(t, &CallTrace{
+			FunctionName: "outer",
+			Caller:       []byte("caller"),
+			Recipient:    []byte("recipient"),
+			Arguments:    [][]byte{[]byte("arg")},
+			GasProvided:  100,
+			Events: []*TraceEvent{
+				{Type: TraceAccountLoaded, Address: []byte("caller")},
+				{Type: TraceStorageWritten, Address: []byte("caller"), Key: []byte("key"), Value: []byte("new value")},
+				{Type: TraceLogEmitted, Log: entry},
+				{Type: TraceOutputTransferCreated, Address: []byte("recipient"), Transfer: transfer},
+				{Type: TraceTransferStep, Step: step},
+			},
+			ReturnCode:   vmcommon.Ok.String(),
+			GasRemaining: 90,
+		}, traces[1])
+
+		dump, err := rt.Dump()
+		require.Nil(t, err)
+		decoded := make([]*CallTrace, 0)
+		err = json.Unmarshal(dump, &decoded)
+		require.Nil(t, err)
+		assert.Equal(t, "outer", decoded[1].FunctionName)
+		assert.Equal(t, 5, len(decoded[1].Events))
+		assert.Equal(t, step, decoded[1].Events[4].Step)
+
+		rt.Reset()
+		assert.Empty(t, rt.Traces())
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inputValidator"
 )
 
 type saveUserName struct {
@@ -69,11 +70,9 @@ func inputCheckForUserNameCall(
 	gasCost uint64,
 	numArgs int,
 ) error {
-	if vmInput == nil {
-		return ErrNilVmInput
-	}
-	if vmInput.CallValue.Cmp(zero) != 0 {
-		return ErrBuiltInFunctionCalledWithValue
+	err := inputValidator.CheckNoCallValue(vmInput)
+	if err != nil {
+		return err
 	}
 	if !check.IfNil(acntSnd) && vmInput.GasProvided < gasCost {
 		return ErrNotEnoughGas
@@ -0,0 +1,159 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// ArgsNewBuiltInFunctionSimulator defines the arguments needed to create a new built-in function simulator
+type ArgsNewBuiltInFunctionSimulator struct {
+	ContainerArgs  ArgsCreateBuiltInFunctionContainer
+	PayableHandler vmcommon.PayableHandler
+}
+
+// SimulationResult holds the output of a simulated built-in function call and the storage keys it touched
+type SimulationResult struct {
+	VMOutput    *vmcommon.VMOutput
+	TouchedKeys []*TouchedKey
+}
+
+// gasProvidedForEstimation is large enough for any built-in function, so the estimation never fails for lack of gas
+const gasProvidedForEstimation = math.MaxUint64
+
+type builtInFunctionSimulator struct {
+	mutSimulation sync.Mutex
+	overlay       *accountsOverlay
+	factory       *builtInFuncCreator
+	executor      *builtInFunctionExecutor
+}
+
+// NewBuiltInFunctionSimulator creates a component able to run built-in functions without mutating the accounts state.
+// It holds its own built-in functions container, working on a copy-on-write overlay of the provided accounts adapter.
+func NewBuiltInFunctionSimulator(args ArgsNewBuiltInFunctionSimulator) (*builtInFunctionSimulator, error) {
+	if check.IfNil(args.ContainerArgs.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.PayableHandler) {
+		return nil, ErrNilPayableHandler
+	}
+
+	overlay := newAccountsOverlay(args.ContainerArgs.Accounts)
+	containerArgs := args.ContainerArgs
+	containerArgs.Accounts = overlay
+
+	factory, err := NewBuiltInFunctionsCreator(containerArgs)
+	if err != nil {
+		return nil, err
+	}
+	err = factory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return nil, err
+	}
+	err = factory.SetPayableHandler(args.PayableHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, err := NewBuiltInFunctionExecutor(ArgsNewBuiltInFunctionExecutor{
+		Accounts:               overlay,
+		ShardCoordinator:       containerArgs.ShardCoordinator,
+		BuiltInFunctionFactory: factory,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &builtInFunctionSimulator{
+		overlay:  overlay,
+		factory:  factory,
+		executor: executor,
+	}, nil
+}
+
+// SimulateBuiltInFunction executes the built-in function as the executor would, returning the full output and the
+// storage keys read or written. All the writes are discarded, so the underlying accounts state remains unchanged.
+func (bfs *builtInFunctionSimulator) SimulateBuiltInFunction(input *vmcommon.ContractCallInput) (*SimulationResult, error) {
+	bfs.mutSimulation.Lock()
+	defer bfs.mutSimulation.Unlock()
+
+	bfs.overlay.reset()
+	defer bfs.overlay.reset()
+
+	vmOutput, err := bfs.executor.ExecuteBuiltInFunction(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimulationResult{
+		VMOutput:    vmOutput,
+		TouchedKeys: bfs.overlay.getTouchedKeys(),
+	}, nil
+}
+
+// EstimateGas returns the gas consumed by the provided built-in function call. The call is simulated on the same code
+// path as the actual execution, so the estimation always matches the charged cost. The gas forwarded to a smart contract
+// call after the built-in function is not part of the estimation.
+func (bfs *builtInFunctionSimulator) EstimateGas(functionName string, input *vmcommon.ContractCallInput) (uint64, error) {
+	if input == nil {
+		return 0, ErrNilVmInput
+	}
+
+	estimationInput := *input
+	estimationInput.Function = functionName
+	estimationInput.GasProvided = gasProvidedForEstimation
+
+	result, err := bfs.SimulateBuiltInFunction(&estimationInput)
+	if err != nil {
+		return 0, err
+	}
+
+	vmOutput := result.VMOutput
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return 0, fmt.Errorf("%w: %s %s", ErrCannotEstimateGas, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return computeGasUsed(estimationInput.GasProvided, vmOutput)
+}
+
+// computeGasUsed returns the gas consumed by the built-in function, without the gas forwarded through output transfers
+func computeGasUsed(gasProvided uint64, vmOutput *vmcommon.VMOutput) (uint64, error) {
+	gasUsed, err := vmcommon.SafeSubUint64(gasProvided, vmOutput.GasRemaining)
+	if err != nil {
+		return 0, err
+	}
+	for _, outAcc := range vmOutput.OutputAccounts {
+		for _, outTransfer := range outAcc.OutputTransfers {
+			gasUsed, err = vmcommon.SafeSubUint64(gasUsed, outTransfer.GasLimit)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return gasUsed, nil
+}
+
+// GasScheduleChange updates the gas schedule of the simulated built-in functions
+func (bfs *builtInFunctionSimulator) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	bfs.mutSimulation.Lock()
+	defer bfs.mutSimulation.Unlock()
+
+	bfs.factory.GasScheduleChange(gasSchedule)
+}
+
+// UpdateGasSchedule updates the gas schedule of the simulated built-in functions, returning the error if rejected
+func (bfs *builtInFunctionSimulator) UpdateGasSchedule(gasSchedule map[string]map[string]uint64) error {
+	bfs.mutSimulation.Lock()
+	defer bfs.mutSimulation.Unlock()
+
+	return bfs.factory.UpdateGasSchedule(gasSchedule)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (bfs *builtInFunctionSimulator) IsInterfaceNil() bool {
+	return bfs == nil
+}
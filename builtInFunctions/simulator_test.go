@@ -0,0 +1,334 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSimulatorArgs(t *testing.T) ArgsNewBuiltInFunctionSimulator {
+	accounts, err := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	require.Nil(t, err)
+
+	containerArgs := createMockArguments()
+	containerArgs.Accounts = accounts
+
+	return ArgsNewBuiltInFunctionSimulator{
+		ContainerArgs:  containerArgs,
+		PayableHandler: &mock.PayableHandlerStub{},
+	}
+}
+
+func TestNewBuiltInFunctionSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		args.ContainerArgs.Accounts = nil
+		bfs, err := NewBuiltInFunctionSimulator(args)
+		assert.Nil(t, bfs)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil payable handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		args.PayableHandler = nil
+		bfs, err := NewBuiltInFunctionSimulator(args)
+		assert.Nil(t, bfs)
+		assert.Equal(t, ErrNilPayableHandler, err)
+	})
+	t.Run("invalid container arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		args.ContainerArgs.Marshalizer = nil
+		bfs, err := NewBuiltInFunctionSimulator(args)
+		assert.Nil(t, bfs)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bfs, err := NewBuiltInFunctionSimulator(createSimulatorArgs(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(bfs))
+	})
+}
+
+func TestBuiltInFunctionSimulator_SimulateBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("execution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		bfs, _ := NewBuiltInFunctionSimulator(createSimulatorArgs(t))
+		result, err := bfs.SimulateBuiltInFunction(&vmcommon.ContractCallInput{Function: "unknown"})
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+	})
+	t.Run("save key value should not change the state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		accounts := args.ContainerArgs.Accounts
+		address := []byte("user-address-of-32-bytes-long-00")
+		rootHashBefore, _ := accounts.RootHash()
+
+		bfs, _ := NewBuiltInFunctionSimulator(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  address,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{[]byte("key"), []byte("value")},
+			},
+			RecipientAddr: address,
+			Function:      core.BuiltInFunctionSaveKeyValue,
+		}
+		result, err := bfs.SimulateBuiltInFunction(input)
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+		assert.Equal(t, []*TouchedKey{{Address: address, Key: []byte("key"), Read: true, Written: true}}, result.TouchedKeys)
+
+		rootHashAfter, _ := accounts.RootHash()
+		assert.Equal(t, rootHashBefore, rootHashAfter)
+		_, err = accounts.GetExistingAccount(address)
+		assert.Equal(t, inMemoryAccounts.ErrAccountNotFound, err)
+	})
+	t.Run("dcdt transfer should see the state but not change it", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		accounts := args.ContainerArgs.Accounts
+		marshaller := args.ContainerArgs.Marshalizer
+		tokenKey := []byte(baseDCDTKeyPrefix + "TKN-abcdef")
+		sender := []byte("sender-address-of-32-bytes-long!")
+		receiver := []byte("receiver-address-of-32-bytes-lon")
+
+		acntSnd := loadUserAccountFromAdapter(t, accounts, sender)
+		balance, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(100)})
+		_ = acntSnd.AccountDataHandler().SaveKeyValue(tokenKey, balance)
+		_ = accounts.SaveAccount(acntSnd)
+		rootHashBefore, _ := accounts.RootHash()
+
+		bfs, _ := NewBuiltInFunctionSimulator(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  sender,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(30).Bytes()},
+			},
+			RecipientAddr: receiver,
+			Function:      core.BuiltInFunctionDCDTTransfer,
+		}
+		for i := 0; i < 2; i++ {
+			result, err := bfs.SimulateBuiltInFunction(input)
+			require.Nil(t, err)
+			assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+			assert.Contains(t, result.TouchedKeys, &TouchedKey{Address: sender, Key: tokenKey, Read: true, Written: true})
+			assert.Contains(t, result.TouchedKeys, &TouchedKey{Address: receiver, Key: tokenKey, Read: true, Written: true})
+		}
+
+		rootHashAfter, _ := accounts.RootHash()
+		assert.Equal(t, rootHashBefore, rootHashAfter)
+		value, _, _ := loadUserAccountFromAdapter(t, accounts, sender).AccountDataHandler().RetrieveValue(tokenKey)
+		assert.Equal(t, balance, value)
+	})
+	t.Run("claim developer rewards should not change the shared base accounts", func(t *testing.T) {
+		t.Parallel()
+
+		owner := []byte("owner-address-of-32-bytes-long-0")
+		contract := append(make([]byte, vmcommon.NumInitCharactersForScAddress), []byte("contract-address-of-22")...)
+		ownerAccount, _ := inMemoryAccounts.NewUserAccount(owner)
+		_ = ownerAccount.AddToBalance(big.NewInt(100))
+		contractAccount, _ := inMemoryAccounts.NewUserAccount(contract)
+		contractAccount.SetOwnerAddress(owner)
+		contractAccount.AddToDeveloperReward(big.NewInt(50))
+
+		// the base adapter hands out the same account objects on every load
+		sharedAccounts := map[string]vmcommon.UserAccountHandler{
+			string(owner):    ownerAccount,
+			string(contract): contractAccount,
+		}
+		args := createSimulatorArgs(t)
+		args.ContainerArgs.Accounts = &mock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return sharedAccounts[string(address)], nil
+			},
+		}
+
+		bfs, _ := NewBuiltInFunctionSimulator(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  owner,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+			},
+			RecipientAddr: contract,
+			Function:      core.BuiltInFunctionClaimDeveloperRewards,
+		}
+		result, err := bfs.SimulateBuiltInFunction(input)
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+		assert.Equal(t, big.NewInt(50), result.VMOutput.OutputAccounts[string(owner)].OutputTransfers[0].Value)
+
+		assert.Equal(t, big.NewInt(100), ownerAccount.GetBalance())
+		assert.Equal(t, big.NewInt(50), contractAccount.GetDeveloperReward())
+	})
+	t.Run("dcdt nft create should return the new nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		accounts := args.ContainerArgs.Accounts
+		marshaller := args.ContainerArgs.Marshalizer
+		creator := []byte("creator-address-of-32-bytes-lon!")
+
+		acnt := loadUserAccountFromAdapter(t, accounts, creator)
+		roles, _ := marshaller.Marshal(&dcdt.DCDTRoles{Roles: [][]byte{[]byte(core.DCDTRoleNFTCreate)}})
+		_ = acnt.AccountDataHandler().SaveKeyValue(append(roleKeyPrefix, []byte("NFT-abcdef")...), roles)
+		_ = accounts.SaveAccount(acnt)
+		rootHashBefore, _ := accounts.RootHash()
+
+		bfs, _ := NewBuiltInFunctionSimulator(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  creator,
+				CallValue:   big.NewInt(0),
+				GasProvided: 1000,
+				Arguments: [][]byte{[]byte("NFT-abcdef"), big.NewInt(1).Bytes(), []byte("name"),
+					big.NewInt(0).Bytes(), []byte("hash"), []byte("attributes"), []byte("uri")},
+			},
+			RecipientAddr: creator,
+			Function:      core.BuiltInFunctionDCDTNFTCreate,
+		}
+		for i := 0; i < 2; i++ {
+			result, err := bfs.SimulateBuiltInFunction(input)
+			require.Nil(t, err)
+			assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+			assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, result.VMOutput.ReturnData)
+		}
+
+		rootHashAfter, _ := accounts.RootHash()
+		assert.Equal(t, rootHashBefore, rootHashAfter)
+	})
+}
+
+func TestBuiltInFunctionSimulator_EstimateGas(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil input should error", func(t *testing.T) {
+		t.Parallel()
+
+		bfs, _ := NewBuiltInFunctionSimulator(createSimulatorArgs(t))
+		gas, err := bfs.EstimateGas(core.BuiltInFunctionSaveKeyValue, nil)
+		assert.Zero(t, gas)
+		assert.Equal(t, ErrNilVmInput, err)
+	})
+	t.Run("execution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		bfs, _ := NewBuiltInFunctionSimulator(createSimulatorArgs(t))
+		gas, err := bfs.EstimateGas("unknown", &vmcommon.ContractCallInput{})
+		assert.Zero(t, gas)
+		assert.NotNil(t, err)
+	})
+	t.Run("save key value should match the charged gas", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		accounts := args.ContainerArgs.Accounts
+		address := []byte("user-address-of-32-bytes-long-00")
+
+		acnt := loadUserAccountFromAdapter(t, accounts, address)
+		_ = acnt.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("old"))
+		_ = accounts.SaveAccount(acnt)
+
+		bfs, _ := NewBuiltInFunctionSimulator(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  address,
+				CallValue:   big.NewInt(0),
+				GasProvided: 10,
+				Arguments:   [][]byte{[]byte("key"), []byte("new value"), []byte("other key"), []byte("value")},
+			},
+			RecipientAddr: address,
+		}
+		gas, err := bfs.EstimateGas(core.BuiltInFunctionSaveKeyValue, input)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(10), input.GasProvided)
+		assert.Empty(t, input.Function)
+
+		input.Function = core.BuiltInFunctionSaveKeyValue
+		input.GasProvided = 1000
+		vmOutput := executeOnState(t, args, input)
+		assert.Equal(t, input.GasProvided-vmOutput.GasRemaining, gas)
+	})
+	t.Run("dcdt transfer and execute should not count the forwarded gas", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSimulatorArgs(t)
+		accounts := args.ContainerArgs.Accounts
+		marshaller := args.ContainerArgs.Marshalizer
+		tokenKey := []byte(baseDCDTKeyPrefix + "TKN-abcdef")
+		sender := []byte("sender-address-of-32-bytes-long!")
+		scAddress := append(make([]byte, 8), []byte("smart-contract-address-of-32b")[:24]...)
+
+		acntSnd := loadUserAccountFromAdapter(t, accounts, sender)
+		balance, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(100)})
+		_ = acntSnd.AccountDataHandler().SaveKeyValue(tokenKey, balance)
+		_ = accounts.SaveAccount(acntSnd)
+
+		bfs, _ := NewBuiltInFunctionSimulator(args)
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  sender,
+				CallValue:   big.NewInt(0),
+				GasProvided: 1000,
+				Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(30).Bytes(), []byte("function")},
+			},
+			RecipientAddr: scAddress,
+			Function:      core.BuiltInFunctionDCDTTransfer,
+		}
+		gas, err := bfs.EstimateGas(core.BuiltInFunctionDCDTTransfer, input)
+		require.Nil(t, err)
+		assert.Equal(t, args.ContainerArgs.GasMap[core.BuiltInCostString][core.BuiltInFunctionDCDTTransfer], gas)
+
+		vmOutput := executeOnState(t, args, input)
+		forwardedGas := vmOutput.OutputAccounts[string(scAddress)].OutputTransfers[0].GasLimit
+		assert.Equal(t, input.GasProvided-vmOutput.GasRemaining-forwardedGas, gas)
+	})
+}
+
+func executeOnState(t *testing.T, args ArgsNewBuiltInFunctionSimulator, input *vmcommon.ContractCallInput) *vmcommon.VMOutput {
+	factory, err := NewBuiltInFunctionsCreator(args.ContainerArgs)
+	require.Nil(t, err)
+	err = factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+	err = factory.SetPayableHandler(args.PayableHandler)
+	require.Nil(t, err)
+
+	bfe, err := NewBuiltInFunctionExecutor(ArgsNewBuiltInFunctionExecutor{
+		Accounts:               args.ContainerArgs.Accounts,
+		ShardCoordinator:       args.ContainerArgs.ShardCoordinator,
+		BuiltInFunctionFactory: factory,
+	})
+	require.Nil(t, err)
+
+	vmOutput, err := bfe.ExecuteBuiltInFunction(input)
+	require.Nil(t, err)
+
+	return vmOutput
+}
@@ -0,0 +1,148 @@
+package builtInFunctions
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.AccountsAdapter = (*tracingAccountsAdapter)(nil)
+
+// tracingAccountsAdapter reports the account loads and the storage accesses of the loaded accounts to the tracer
+type tracingAccountsAdapter struct {
+	vmcommon.AccountsAdapter
+	tracer Tracer
+}
+
+func newTracingAccountsAdapter(accounts vmcommon.AccountsAdapter, tracer Tracer) *tracingAccountsAdapter {
+	return &tracingAccountsAdapter{
+		AccountsAdapter: accounts,
+		tracer:          tracer,
+	}
+}
+
+// GetExistingAccount loads the account from the underlying adapter and reports it to the tracer
+func (taa *tracingAccountsAdapter) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := taa.AccountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return taa.traceAccount(account), nil
+}
+
+// LoadAccount loads the account from the underlying adapter and reports it to the tracer
+func (taa *tracingAccountsAdapter) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := taa.AccountsAdapter.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return taa.traceAccount(account), nil
+}
+
+func (taa *tracingAccountsAdapter) traceAccount(account vmcommon.AccountHandler) vmcommon.AccountHandler {
+	taa.tracer.AccountLoaded(account.AddressBytes())
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return account
+	}
+
+	return newTracingAccount(userAccount, taa.tracer)
+}
+
+// SaveAccount saves the account, without the tracing wrapper, in the underlying adapter
+func (taa *tracingAccountsAdapter) SaveAccount(account vmcommon.AccountHandler) error {
+	tracedAccount, ok := account.(*tracingAccount)
+	if ok {
+		return taa.AccountsAdapter.SaveAccount(tracedAccount.UserAccountHandler)
+	}
+
+	return taa.AccountsAdapter.SaveAccount(account)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (taa *tracingAccountsAdapter) IsInterfaceNil() bool {
+	return taa == nil
+}
+
+// tracingAccount wraps an account, reporting its storage accesses to the tracer
+type tracingAccount struct {
+	vmcommon.UserAccountHandler
+	tracer Tracer
+}
+
+func newTracingAccount(account vmcommon.UserAccountHandler, tracer Tracer) *tracingAccount {
+	return &tracingAccount{
+		UserAccountHandler: account,
+		tracer:             tracer,
+	}
+}
+
+// traceUserAccount wraps the provided account, keeping the nil accounts unchanged
+func traceUserAccount(account vmcommon.UserAccountHandler, tracer Tracer) vmcommon.UserAccountHandler {
+	if check.IfNil(account) {
+		return account
+	}
+	_, isTraced := account.(*tracingAccount)
+	if isTraced {
+		return account
+	}
+
+	return newTracingAccount(account, tracer)
+}
+
+// AccountDataHandler returns the storage of the account, reporting the reads and writes to the tracer
+func (ta *tracingAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return &tracingDataHandler{
+		AccountDataHandler: ta.UserAccountHandler.AccountDataHandler(),
+		address:            ta.AddressBytes(),
+		tracer:             ta.tracer,
+	}
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ta *tracingAccount) IsInterfaceNil() bool {
+	return ta == nil
+}
+
+type tracingDataHandler struct {
+	vmcommon.AccountDataHandler
+	address []byte
+	tracer  Tracer
+}
+
+// RetrieveValue reads the value from the account storage and reports it to the tracer
+func (tdh *tracingDataHandler) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, trieDepth, err := tdh.AccountDataHandler.RetrieveValue(key)
+	if err == nil {
+		tdh.tracer.StorageRead(tdh.address, key, value)
+	}
+
+	return value, trieDepth, err
+}
+
+// SaveKeyValue writes the value in the account storage and reports it to the tracer
+func (tdh *tracingDataHandler) SaveKeyValue(key []byte, value []byte) error {
+	err := tdh.AccountDataHandler.SaveKeyValue(key, value)
+	if err == nil {
+		tdh.tracer.StorageWritten(tdh.address, key, value)
+	}
+
+	return err
+}
+
+// IterateKeysWithPrefix iterates over the account storage, if the underlying storage supports it
+func (tdh *tracingDataHandler) IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error {
+	iterator, ok := tdh.AccountDataHandler.(vmcommon.AccountDataIterator)
+	if !ok {
+		return ErrIterationNotSupported
+	}
+
+	return iterator.IterateKeysWithPrefix(prefix, handler)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (tdh *tracingDataHandler) IsInterfaceNil() bool {
+	return tdh == nil
+}
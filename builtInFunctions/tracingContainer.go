@@ -0,0 +1,98 @@
+package builtInFunctions
+
+import (
+	"sort"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.BuiltInFunctionContainer = (*tracingContainer)(nil)
+var _ vmcommon.BuiltinFunction = (*tracingBuiltInFunction)(nil)
+
+// tracingContainer wraps a built-in functions container, so that every built-in function returned by Get reports
+// its execution steps to the tracer
+type tracingContainer struct {
+	vmcommon.BuiltInFunctionContainer
+	tracer Tracer
+}
+
+func newTracingContainer(container vmcommon.BuiltInFunctionContainer, tracer Tracer) *tracingContainer {
+	return &tracingContainer{
+		BuiltInFunctionContainer: container,
+		tracer:                   tracer,
+	}
+}
+
+// Get returns the traced built-in function stored at the provided key. The optional interfaces of the built-in
+// function, like vmcommon.BlockchainDataProvider or vmcommon.AcceptPayableChecker, remain reachable on the result.
+func (tc *tracingContainer) Get(key string) (vmcommon.BuiltinFunction, error) {
+	builtInFunc, err := tc.BuiltInFunctionContainer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	traced := &tracingBuiltInFunction{
+		BuiltinFunction: builtInFunc,
+		name:            key,
+		tracer:          tc.tracer,
+	}
+
+	return withOptionalInterfaces(traced, builtInFunc), nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (tc *tracingContainer) IsInterfaceNil() bool {
+	return tc == nil
+}
+
+type tracingBuiltInFunction struct {
+	vmcommon.BuiltinFunction
+	name   string
+	tracer Tracer
+}
+
+// ProcessBuiltinFunction executes the wrapped built-in function, reporting the storage accesses of the provided
+// accounts, the logs and the output transfers to the tracer. The output is reported even if the execution failed.
+func (tbf *tracingBuiltInFunction) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	tbf.tracer.BeginFunction(tbf.name, vmInput)
+
+	vmOutput, err := tbf.BuiltinFunction.ProcessBuiltinFunction(
+		traceUserAccount(acntSnd, tbf.tracer),
+		traceUserAccount(acntDst, tbf.tracer),
+		vmInput,
+	)
+	if vmOutput != nil {
+		tbf.traceOutput(vmOutput)
+	}
+
+	tbf.tracer.EndFunction(tbf.name, vmOutput, err)
+
+	return vmOutput, err
+}
+
+func (tbf *tracingBuiltInFunction) traceOutput(vmOutput *vmcommon.VMOutput) {
+	for _, entry := range vmOutput.Logs {
+		tbf.tracer.LogEmitted(entry)
+	}
+
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		outAcc := vmOutput.OutputAccounts[address]
+		for i := range outAcc.OutputTransfers {
+			tbf.tracer.OutputTransferCreated([]byte(address), &outAcc.OutputTransfers[i])
+		}
+	}
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (tbf *tracingBuiltInFunction) IsInterfaceNil() bool {
+	return tbf == nil
+}
@@ -0,0 +1,251 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTracedExecutor(t *testing.T, tracer Tracer) (*builtInFunctionExecutor, vmcommon.AccountsAdapter, vmcommon.Marshalizer) {
+	accounts, err := inMemoryAccounts.NewAccountsAdapter(sha256.NewSha256())
+	require.Nil(t, err)
+
+	args := createMockArguments()
+	args.Accounts = accounts
+	args.Tracer = tracer
+	args.EnableEpochsHandler = &mock.EnableEpochsHandlerStub{
+		IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+			return flag == DCDTNFTImprovementV1Flag
+		},
+	}
+	factory, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+	err = factory.SetPayableHandler(&mock.PayableHandlerStub{})
+	require.Nil(t, err)
+
+	bfe, err := NewBuiltInFunctionExecutor(ArgsNewBuiltInFunctionExecutor{
+		Accounts:               accounts,
+		ShardCoordinator:       args.ShardCoordinator,
+		BuiltInFunctionFactory: factory,
+	})
+	require.Nil(t, err)
+
+	return bfe, accounts, args.Marshalizer
+}
+
+func TestBuiltInFuncCreator_BuiltInFunctionContainerWithTracer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	f, _ := NewBuiltInFunctionsCreator(args)
+	_, isTraced := f.BuiltInFunctionContainer().(*tracingContainer)
+	assert.False(t, isTraced)
+
+	args.Tracer = NewRecordingTracer()
+	f, _ = NewBuiltInFunctionsCreator(args)
+	_ = f.CreateBuiltInFunctionContainer()
+	container := f.BuiltInFunctionContainer()
+	_, isTraced = container.(*tracingContainer)
+	assert.True(t, isTraced)
+	assert.Equal(t, f.builtInFunctions.Len(), container.Len())
+}
+
+func TestTracingBuiltInFunction_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	tokenKey := []byte(baseDCDTKeyPrefix + "TKN-abcdef")
+	sender := []byte("sender-address-of-32-bytes-long!")
+	receiver := []byte("receiver-address-of-32-bytes-lon")
+
+	t.Run("successful transfer should record the storage accesses and the log", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := NewRecordingTracer()
+		bfe, accounts, marshaller := createTracedExecutor(t, tracer)
+		acntSnd := loadUserAccountFromAdapter(t, accounts, sender)
+		balance, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(100)})
+		_ = acntSnd.AccountDataHandler().SaveKeyValue(tokenKey, balance)
+		_ = accounts.SaveAccount(acntSnd)
+
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  sender,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(30).Bytes()},
+			},
+			RecipientAddr: receiver,
+			Function:      core.BuiltInFunctionDCDTTransfer,
+		}
+		vmOutput, err := bfe.ExecuteBuiltInFunction(input)
+		require.Nil(t, err)
+
+		traces := tracer.Traces()
+		require.Equal(t, 1, len(traces))
+		trace := traces[0]
+		assert.Equal(t, core.BuiltInFunctionDCDTTransfer, trace.FunctionName)
+		assert.Equal(t, vmcommon.Ok.String(), trace.ReturnCode)
+		assert.Empty(t, trace.Error)
+		assert.Contains(t, trace.Events, &TraceEvent{Type: TraceStorageRead, Address: sender, Key: tokenKey, Value: balance})
+		assert.Contains(t, trace.Events, &TraceEvent{Type: TraceLogEmitted, Log: vmOutput.Logs[0]})
+		assert.Contains(t, trace.Events, &TraceEvent{Type: TraceTransferStep, Step: &TransferStep{
+			Type:     TransferStepDebitSender,
+			Sender:   sender,
+			Receiver: receiver,
+			TokenID:  []byte("TKN-abcdef"),
+			Value:    big.NewInt(30),
+		}})
+		assert.Contains(t, trace.Events, &TraceEvent{Type: TraceTransferStep, Step: &TransferStep{
+			Type:     TransferStepCreditDestination,
+			Sender:   sender,
+			Receiver: receiver,
+			TokenID:  []byte("TKN-abcdef"),
+			Value:    big.NewInt(30),
+		}})
+
+		numWrites := 0
+		for _, event := range trace.Events {
+			if event.Type == TraceStorageWritten && string(event.Key) == string(tokenKey) {
+				numWrites++
+			}
+		}
+		assert.Equal(t, 2, numWrites)
+	})
+	t.Run("failed transfer should record the error without any step", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := NewRecordingTracer()
+		bfe, _, _ := createTracedExecutor(t, tracer)
+
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  sender,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(30).Bytes()},
+			},
+			RecipientAddr: receiver,
+			Function:      core.BuiltInFunctionDCDTTransfer,
+		}
+		_, err := bfe.ExecuteBuiltInFunction(input)
+		require.NotNil(t, err)
+
+		traces := tracer.Traces()
+		require.Equal(t, 1, len(traces))
+		assert.Equal(t, err.Error(), traces[0].Error)
+		assert.Contains(t, traces[0].Events, &TraceEvent{Type: TraceStorageRead, Address: sender, Key: tokenKey, Value: nil})
+		assertNoTransferStep(t, traces[0])
+	})
+	t.Run("failed multi transfer should record the error without any step", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := NewRecordingTracer()
+		bfe, accounts, marshaller := createTracedExecutor(t, tracer)
+		acntSnd := loadUserAccountFromAdapter(t, accounts, sender)
+		balance, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(10)})
+		_ = acntSnd.AccountDataHandler().SaveKeyValue(tokenKey, balance)
+		_ = accounts.SaveAccount(acntSnd)
+
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  sender,
+				CallValue:   big.NewInt(0),
+				GasProvided: 100,
+				Arguments:   [][]byte{receiver, big.NewInt(1).Bytes(), []byte("TKN-abcdef"), big.NewInt(0).Bytes(), big.NewInt(30).Bytes()},
+			},
+			RecipientAddr: sender,
+			Function:      core.BuiltInFunctionMultiDCDTNFTTransfer,
+		}
+		_, err := bfe.ExecuteBuiltInFunction(input)
+		require.True(t, errors.Is(err, ErrInsufficientQuantityDCDT))
+
+		traces := tracer.Traces()
+		require.Equal(t, 1, len(traces))
+		assert.Equal(t, err.Error(), traces[0].Error)
+		assertNoTransferStep(t, traces[0])
+	})
+	t.Run("the output should be recorded even if the execution failed", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := NewRecordingTracer()
+		entry := &vmcommon.LogEntry{Identifier: []byte("internalVMErrors")}
+		expectedErr := errors.New("expected error")
+		tbf := &tracingBuiltInFunction{
+			BuiltinFunction: &mock.BuiltInFunctionStub{
+				ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					return &vmcommon.VMOutput{Logs: []*vmcommon.LogEntry{entry}}, expectedErr
+				},
+			},
+			name:   "function",
+			tracer: tracer,
+		}
+
+		_, err := tbf.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+		assert.Equal(t, expectedErr, err)
+
+		traces := tracer.Traces()
+		require.Equal(t, 1, len(traces))
+		assert.Equal(t, expectedErr.Error(), traces[0].Error)
+		assert.Equal(t, []*TraceEvent{{Type: TraceLogEmitted, Log: entry}}, traces[0].Events)
+	})
+}
+
+func TestTracingContainer_GetShouldKeepTheOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.Tracer = NewRecordingTracer()
+	factory, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	container := factory.BuiltInFunctionContainer()
+	payableChecker, _ := NewPayableCheckFunc(&mock.PayableHandlerStub{}, args.EnableEpochsHandler)
+	for _, funcName := range []string{core.BuiltInFunctionDCDTTransfer, core.BuiltInFunctionDCDTNFTTransfer, core.BuiltInFunctionMultiDCDTNFTTransfer} {
+		builtInFunc, errGet := container.Get(funcName)
+		require.Nil(t, errGet)
+
+		acceptPayable, ok := builtInFunc.(vmcommon.AcceptPayableChecker)
+		require.True(t, ok, funcName)
+		assert.Nil(t, acceptPayable.SetPayableChecker(payableChecker))
+
+		unwrapped, _ := factory.builtInFunctions.Get(funcName)
+		assert.Equal(t, args.Tracer, getTracerOfTransferFunction(unwrapped))
+	}
+
+	err = factory.SetPayableHandler(&mock.PayableHandlerStub{})
+	assert.Nil(t, err)
+	err = factory.SetBlockchainHook(&disabledBlockchainHook{})
+	assert.Nil(t, err)
+}
+
+func getTracerOfTransferFunction(builtInFunc vmcommon.BuiltinFunction) Tracer {
+	switch transferFunc := builtInFunc.(type) {
+	case *dcdtTransfer:
+		return transferFunc.tracer
+	case *dcdtNFTTransfer:
+		return transferFunc.tracer
+	case *dcdtNFTMultiTransfer:
+		return transferFunc.tracer
+	default:
+		return nil
+	}
+}
+
+func assertNoTransferStep(t *testing.T, trace *CallTrace) {
+	for _, event := range trace.Events {
+		assert.NotEqual(t, TraceTransferStep, event.Type)
+	}
+}
@@ -0,0 +1,55 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+)
+
+// TransferStepType defines the type of balance change executed by the transfer built-in functions
+type TransferStepType string
+
+const (
+	// TransferStepDebitSender is the step removing the tokens from the sender account
+	TransferStepDebitSender TransferStepType = "debitSender"
+	// TransferStepCreditDestination is the step adding the tokens to the destination account
+	TransferStepCreditDestination TransferStepType = "creditDestination"
+	// TransferStepCrossShard is the step sending the tokens towards a destination located in another shard
+	TransferStepCrossShard TransferStepType = "crossShard"
+)
+
+// TransferStep is a balance change executed by a transfer built-in function. Only the completed steps are reported,
+// the failure of a call is reported once, by the tracing wrapper, when the function ends.
+type TransferStep struct {
+	Type     TransferStepType `json:"type"`
+	Sender   []byte           `json:"sender,omitempty"`
+	Receiver []byte           `json:"receiver,omitempty"`
+	TokenID  []byte           `json:"tokenID,omitempty"`
+	Nonce    uint64           `json:"nonce"`
+	Value    *big.Int         `json:"value,omitempty"`
+}
+
+// traceTransferStep reports the transfer step to the tracer. Nothing is allocated if no tracer is set, as the
+// transfer functions run without one unless tracing is enabled.
+func traceTransferStep(
+	tracer Tracer,
+	stepType TransferStepType,
+	sender []byte,
+	receiver []byte,
+	tokenID []byte,
+	nonce uint64,
+	value *big.Int,
+) {
+	if check.IfNil(tracer) {
+		return
+	}
+
+	tracer.TransferStep(&TransferStep{
+		Type:     stepType,
+		Sender:   copyBytes(sender),
+		Receiver: copyBytes(receiver),
+		TokenID:  copyBytes(tokenID),
+		Nonce:    nonce,
+		Value:    copyBigInt(value),
+	})
+}
@@ -0,0 +1,272 @@
+package vmcommon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"sort"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+)
+
+// canonicalEncodingVersion is written first in every canonical encoding, so the format can be changed without
+// producing equal encodings for different contents
+const canonicalEncodingVersion byte = 1
+
+const (
+	canonicalVMOutputTag byte = iota + 1
+	canonicalVMInputTag
+	canonicalContractCallInputTag
+	canonicalContractCreateInputTag
+)
+
+// CanonicalEncoder defines the components having a deterministic binary encoding
+type CanonicalEncoder interface {
+	CanonicalBytes() []byte
+}
+
+// ComputeCanonicalHash returns the hash of the canonical encoding of the provided component
+func ComputeCanonicalHash(encoder CanonicalEncoder, hasher hashing.Hasher) ([]byte, error) {
+	if check.IfNilReflect(encoder) {
+		return nil, ErrNilCanonicalEncoder
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return hasher.Compute(string(encoder.CanonicalBytes())), nil
+}
+
+// CanonicalBytes returns the deterministic binary encoding of the output, so outputs can be compared byte for byte.
+// The output accounts and their storage updates are sorted by key, while the output transfers, the logs and the
+// address lists keep their order. Nil and empty byte slices, as well as nil and zero big integers, are encoded the same.
+func (vmOutput *VMOutput) CanonicalBytes() []byte {
+	writer := newCanonicalWriter(canonicalVMOutputTag)
+	writer.writeBytesList(vmOutput.ReturnData)
+	writer.writeUint64(uint64(vmOutput.ReturnCode))
+	writer.writeBytes([]byte(vmOutput.ReturnMessage))
+	writer.writeUint64(vmOutput.GasRemaining)
+	writer.writeBigInt(vmOutput.GasRefund)
+
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	writer.writeUint32(uint32(len(addresses)))
+	for _, address := range addresses {
+		writer.writeBytes([]byte(address))
+		writer.writeOutputAccount(vmOutput.OutputAccounts[address])
+	}
+
+	writer.writeBytesList(vmOutput.DeletedAccounts)
+	writer.writeBytesList(vmOutput.TouchedAccounts)
+
+	writer.writeUint32(uint32(len(vmOutput.Logs)))
+	for _, logEntry := range vmOutput.Logs {
+		writer.writeLogEntry(logEntry)
+	}
+
+	return writer.buffer.Bytes()
+}
+
+// CanonicalBytes returns the deterministic binary encoding of the input fields common to all the calls
+func (vmInput *VMInput) CanonicalBytes() []byte {
+	writer := newCanonicalWriter(canonicalVMInputTag)
+	writer.writeVMInput(vmInput)
+
+	return writer.buffer.Bytes()
+}
+
+// CanonicalBytes returns the deterministic binary encoding of the contract call input
+func (input *ContractCallInput) CanonicalBytes() []byte {
+	writer := newCanonicalWriter(canonicalContractCallInputTag)
+	writer.writeVMInput(&input.VMInput)
+	writer.writeBytes(input.RecipientAddr)
+	writer.writeBytes([]byte(input.Function))
+	writer.writeBool(input.AllowInitFunction)
+
+	return writer.buffer.Bytes()
+}
+
+// CanonicalBytes returns the deterministic binary encoding of the contract create input
+func (input *ContractCreateInput) CanonicalBytes() []byte {
+	writer := newCanonicalWriter(canonicalContractCreateInputTag)
+	writer.writeVMInput(&input.VMInput)
+	writer.writeBytes(input.ContractCode)
+	writer.writeBytes(input.ContractCodeMetadata)
+
+	return writer.buffer.Bytes()
+}
+
+// canonicalWriter writes integers as fixed size big endian values and byte slices prefixed by their length
+type canonicalWriter struct {
+	buffer *bytes.Buffer
+}
+
+func newCanonicalWriter(tag byte) *canonicalWriter {
+	writer := &canonicalWriter{
+		buffer: &bytes.Buffer{},
+	}
+	writer.buffer.WriteByte(canonicalEncodingVersion)
+	writer.buffer.WriteByte(tag)
+
+	return writer
+}
+
+func (writer *canonicalWriter) writeOutputAccount(outAcc *OutputAccount) {
+	writer.writeBool(outAcc != nil)
+	if outAcc == nil {
+		return
+	}
+
+	writer.writeBytes(outAcc.Address)
+	writer.writeUint64(outAcc.Nonce)
+	writer.writeBigInt(outAcc.Balance)
+
+	keys := make([]string, 0, len(outAcc.StorageUpdates))
+	for key := range outAcc.StorageUpdates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	writer.writeUint32(uint32(len(keys)))
+	for _, key := range keys {
+		writer.writeBytes([]byte(key))
+		writer.writeStorageUpdate(outAcc.StorageUpdates[key])
+	}
+
+	writer.writeBytes(outAcc.Code)
+	writer.writeBytes(outAcc.CodeMetadata)
+	writer.writeBytes(outAcc.CodeDeployerAddress)
+	writer.writeBigInt(outAcc.BalanceDelta)
+
+	writer.writeUint32(uint32(len(outAcc.OutputTransfers)))
+	for i := range outAcc.OutputTransfers {
+		writer.writeOutputTransfer(&outAcc.OutputTransfers[i])
+	}
+
+	writer.writeUint64(outAcc.GasUsed)
+	writer.writeUint64(outAcc.BytesAddedToStorage)
+	writer.writeUint64(outAcc.BytesDeletedFromStorage)
+	writer.writeUint64(outAcc.BytesConsumedByTxAsNetworking)
+}
+
+func (writer *canonicalWriter) writeStorageUpdate(storageUpdate *StorageUpdate) {
+	writer.writeBool(storageUpdate != nil)
+	if storageUpdate == nil {
+		return
+	}
+
+	writer.writeBytes(storageUpdate.Offset)
+	writer.writeBytes(storageUpdate.Data)
+	writer.writeBool(storageUpdate.Written)
+}
+
+func (writer *canonicalWriter) writeOutputTransfer(transfer *OutputTransfer) {
+	writer.writeUint32(transfer.Index)
+	writer.writeBigInt(transfer.Value)
+	writer.writeUint64(transfer.GasLimit)
+	writer.writeUint64(transfer.GasLocked)
+	writer.writeBytes(transfer.AsyncData)
+	writer.writeBytes(transfer.Data)
+	writer.writeUint64(uint64(transfer.CallType))
+	writer.writeBytes(transfer.SenderAddress)
+}
+
+func (writer *canonicalWriter) writeLogEntry(logEntry *LogEntry) {
+	writer.writeBool(logEntry != nil)
+	if logEntry == nil {
+		return
+	}
+
+	writer.writeBytes(logEntry.Identifier)
+	writer.writeBytes(logEntry.Address)
+	writer.writeBytesList(logEntry.Topics)
+	writer.writeBytesList(logEntry.Data)
+}
+
+func (writer *canonicalWriter) writeVMInput(vmInput *VMInput) {
+	writer.writeBytes(vmInput.CallerAddr)
+	writer.writeBytesList(vmInput.Arguments)
+
+	writer.writeBool(vmInput.AsyncArguments != nil)
+	if vmInput.AsyncArguments != nil {
+		writer.writeBytes(vmInput.AsyncArguments.CallID)
+		writer.writeBytes(vmInput.AsyncArguments.CallerCallID)
+		writer.writeBytes(vmInput.AsyncArguments.CallbackAsyncInitiatorCallID)
+		writer.writeUint64(vmInput.AsyncArguments.GasAccumulated)
+	}
+
+	writer.writeBigInt(vmInput.CallValue)
+	writer.writeUint64(uint64(vmInput.CallType))
+	writer.writeUint64(vmInput.GasPrice)
+	writer.writeUint64(vmInput.GasProvided)
+	writer.writeUint64(vmInput.GasLocked)
+	writer.writeBytes(vmInput.OriginalTxHash)
+	writer.writeBytes(vmInput.CurrentTxHash)
+	writer.writeBytes(vmInput.PrevTxHash)
+
+	writer.writeUint32(uint32(len(vmInput.DCDTTransfers)))
+	for _, transfer := range vmInput.DCDTTransfers {
+		writer.writeBool(transfer != nil)
+		if transfer == nil {
+			continue
+		}
+
+		writer.writeBigInt(transfer.DCDTValue)
+		writer.writeBytes(transfer.DCDTTokenName)
+		writer.writeUint32(transfer.DCDTTokenType)
+		writer.writeUint64(transfer.DCDTTokenNonce)
+	}
+
+	writer.writeBool(vmInput.ReturnCallAfterError)
+	writer.writeBytes(vmInput.TxGuardian)
+	writer.writeBytes(vmInput.OriginalCallerAddr)
+	writer.writeBytes(vmInput.RelayerAddr)
+}
+
+func (writer *canonicalWriter) writeUint32(value uint32) {
+	var encoded [4]byte
+	binary.BigEndian.PutUint32(encoded[:], value)
+	writer.buffer.Write(encoded[:])
+}
+
+func (writer *canonicalWriter) writeUint64(value uint64) {
+	var encoded [8]byte
+	binary.BigEndian.PutUint64(encoded[:], value)
+	writer.buffer.Write(encoded[:])
+}
+
+func (writer *canonicalWriter) writeBool(value bool) {
+	if value {
+		writer.buffer.WriteByte(1)
+		return
+	}
+
+	writer.buffer.WriteByte(0)
+}
+
+func (writer *canonicalWriter) writeBytes(value []byte) {
+	writer.writeUint32(uint32(len(value)))
+	writer.buffer.Write(value)
+}
+
+func (writer *canonicalWriter) writeBytesList(values [][]byte) {
+	writer.writeUint32(uint32(len(values)))
+	for _, value := range values {
+		writer.writeBytes(value)
+	}
+}
+
+// writeBigInt writes the sign followed by the magnitude, a nil value being written as zero
+func (writer *canonicalWriter) writeBigInt(value *big.Int) {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	writer.buffer.WriteByte(byte(value.Sign() + 1))
+	writer.writeBytes(value.Bytes())
+}
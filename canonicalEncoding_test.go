@@ -0,0 +1,133 @@
+package vmcommon
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/stretchr/testify/require"
+)
+
+func createVMOutputForCanonicalEncoding(numAccounts int) *VMOutput {
+	vmOutput := &VMOutput{
+		ReturnData:     [][]byte{[]byte("result")},
+		ReturnCode:     Ok,
+		GasRemaining:   100,
+		GasRefund:      big.NewInt(0),
+		OutputAccounts: make(map[string]*OutputAccount),
+		Logs:           []*LogEntry{{Identifier: []byte("id"), Topics: [][]byte{[]byte("topic")}}},
+	}
+	for i := 0; i < numAccounts; i++ {
+		address := []byte(fmt.Sprintf("address%d", i))
+		outAcc := &OutputAccount{
+			Address:        address,
+			BalanceDelta:   big.NewInt(int64(-i)),
+			StorageUpdates: make(map[string]*StorageUpdate),
+			OutputTransfers: []OutputTransfer{
+				{Index: uint32(2*i + 1), Value: big.NewInt(1), CallType: vm.AsynchronousCall},
+				{Index: uint32(2*i + 2), Value: big.NewInt(2)},
+			},
+		}
+		for j := 0; j < 10; j++ {
+			key := []byte(fmt.Sprintf("key%d", j))
+			outAcc.StorageUpdates[string(key)] = &StorageUpdate{Offset: key, Data: []byte{byte(j)}, Written: j%2 == 0}
+		}
+		vmOutput.OutputAccounts[string(address)] = outAcc
+	}
+
+	return vmOutput
+}
+
+func TestVMOutput_CanonicalBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should not depend on the maps order", func(t *testing.T) {
+		t.Parallel()
+
+		expected := createVMOutputForCanonicalEncoding(20).CanonicalBytes()
+		for i := 0; i < 20; i++ {
+			require.Equal(t, expected, createVMOutputForCanonicalEncoding(20).CanonicalBytes())
+		}
+	})
+	t.Run("nil and empty values should be encoded the same", func(t *testing.T) {
+		t.Parallel()
+
+		withNilValues := &VMOutput{ReturnData: nil, GasRefund: nil}
+		withEmptyValues := &VMOutput{ReturnData: make([][]byte, 0), GasRefund: big.NewInt(0)}
+		require.Equal(t, withNilValues.CanonicalBytes(), withEmptyValues.CanonicalBytes())
+	})
+	t.Run("any change should change the encoding", func(t *testing.T) {
+		t.Parallel()
+
+		expected := createVMOutputForCanonicalEncoding(3).CanonicalBytes()
+
+		vmOutput := createVMOutputForCanonicalEncoding(3)
+		vmOutput.OutputAccounts["address1"].StorageUpdates["key3"].Written = true
+		require.NotEqual(t, expected, vmOutput.CanonicalBytes())
+
+		vmOutput = createVMOutputForCanonicalEncoding(3)
+		vmOutput.OutputAccounts["address2"].BalanceDelta = big.NewInt(2)
+		require.NotEqual(t, expected, vmOutput.CanonicalBytes())
+
+		vmOutput = createVMOutputForCanonicalEncoding(3)
+		transfers := vmOutput.OutputAccounts["address0"].OutputTransfers
+		transfers[0], transfers[1] = transfers[1], transfers[0]
+		require.NotEqual(t, expected, vmOutput.CanonicalBytes())
+
+		vmOutput = createVMOutputForCanonicalEncoding(3)
+		vmOutput.ReturnData = [][]byte{[]byte("res"), []byte("ult")}
+		require.NotEqual(t, expected, vmOutput.CanonicalBytes())
+	})
+}
+
+func TestVMInput_CanonicalBytes(t *testing.T) {
+	t.Parallel()
+
+	vmInput := VMInput{
+		CallerAddr:    []byte("caller"),
+		Arguments:     [][]byte{[]byte("arg")},
+		CallValue:     big.NewInt(10),
+		GasProvided:   1000,
+		DCDTTransfers: []*DCDTTransfer{{DCDTValue: big.NewInt(1), DCDTTokenName: []byte("TKN")}},
+	}
+	callInput := &ContractCallInput{VMInput: vmInput, RecipientAddr: []byte("recipient"), Function: "function"}
+	createInput := &ContractCreateInput{VMInput: vmInput}
+
+	require.Equal(t, vmInput.CanonicalBytes(), vmInput.CanonicalBytes())
+	require.NotEqual(t, vmInput.CanonicalBytes(), callInput.CanonicalBytes())
+	require.NotEqual(t, vmInput.CanonicalBytes(), createInput.CanonicalBytes())
+	require.NotEqual(t, callInput.VMInput.CanonicalBytes(), callInput.CanonicalBytes())
+
+	otherCallInput := *callInput
+	otherCallInput.Function = "other"
+	require.NotEqual(t, callInput.CanonicalBytes(), otherCallInput.CanonicalBytes())
+}
+
+func TestComputeCanonicalHash(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.NewSha256()
+
+	hash, err := ComputeCanonicalHash(nil, hasher)
+	require.Nil(t, hash)
+	require.Equal(t, ErrNilCanonicalEncoder, err)
+
+	var nilOutput *VMOutput
+	hash, err = ComputeCanonicalHash(nilOutput, hasher)
+	require.Nil(t, hash)
+	require.Equal(t, ErrNilCanonicalEncoder, err)
+
+	hash, err = ComputeCanonicalHash(&VMOutput{}, nil)
+	require.Nil(t, hash)
+	require.Equal(t, ErrNilHasher, err)
+
+	vmOutput := createVMOutputForCanonicalEncoding(5)
+	hash, err = ComputeCanonicalHash(vmOutput, hasher)
+	require.Nil(t, err)
+	require.Equal(t, hasher.Compute(string(vmOutput.CanonicalBytes())), hash)
+
+	otherHash, _ := ComputeCanonicalHash(createVMOutputForCanonicalEncoding(5), hasher)
+	require.Equal(t, hash, otherHash)
+}
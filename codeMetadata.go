@@ -1,5 +1,7 @@
 package vmcommon
 
+import "fmt"
+
 const lengthOfCodeMetadata = 2
 
 // Const group for the first byte of the metadata
@@ -20,6 +22,9 @@ const (
 	MetadataPayableBySC = 4
 )
 
+const knownMetadataBitsFirstByte = MetadataUpgradeable | MetadataReadable | MetadataGuarded
+const knownMetadataBitsSecondByte = MetadataPayable | MetadataPayableBySC
+
 // CodeMetadata represents smart contract code metadata
 type CodeMetadata struct {
 	Payable     bool
@@ -44,6 +49,21 @@ func CodeMetadataFromBytes(bytes []byte) CodeMetadata {
 	}
 }
 
+// CheckCodeMetadataBytes returns an error if the bytes do not have the code metadata length or hold unknown flags,
+// cases in which CodeMetadataFromBytes would silently drop the information
+func CheckCodeMetadataBytes(bytes []byte) error {
+	if len(bytes) != lengthOfCodeMetadata {
+		return fmt.Errorf("%w, expected %d, got %d", ErrInvalidCodeMetadataLength, lengthOfCodeMetadata, len(bytes))
+	}
+
+	unknownBits := []byte{bytes[0] &^ knownMetadataBitsFirstByte, bytes[1] &^ knownMetadataBitsSecondByte}
+	if unknownBits[0] != 0 || unknownBits[1] != 0 {
+		return fmt.Errorf("%w: %08b %08b", ErrUnknownCodeMetadataBits, unknownBits[0], unknownBits[1])
+	}
+
+	return nil
+}
+
 // ToBytes converts the metadata to bytes
 func (metadata *CodeMetadata) ToBytes() []byte {
 	bytes := make([]byte, lengthOfCodeMetadata)
@@ -1,6 +1,7 @@
 package vmcommon
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,3 +36,15 @@ func TestCodeMetadata_ToBytes(t *testing.T) {
 	require.Equal(t, byte(4), (&CodeMetadata{PayableBySC: true}).ToBytes()[1])
 	require.Equal(t, byte(8), (&CodeMetadata{Guarded: true}).ToBytes()[0])
 }
+
+func TestCheckCodeMetadataBytes(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, errors.Is(CheckCodeMetadataBytes(nil), ErrInvalidCodeMetadataLength))
+	require.True(t, errors.Is(CheckCodeMetadataBytes([]byte{1, 2, 0}), ErrInvalidCodeMetadataLength))
+	require.True(t, errors.Is(CheckCodeMetadataBytes([]byte{2, 0}), ErrUnknownCodeMetadataBits))
+	require.True(t, errors.Is(CheckCodeMetadataBytes([]byte{0, 1}), ErrUnknownCodeMetadataBits))
+	require.True(t, errors.Is(CheckCodeMetadataBytes([]byte{0x80, 0}), ErrUnknownCodeMetadataBits))
+	require.Nil(t, CheckCodeMetadataBytes([]byte{0, 0}))
+	require.Nil(t, CheckCodeMetadataBytes([]byte{MetadataUpgradeable | MetadataReadable | MetadataGuarded, MetadataPayable | MetadataPayableBySC}))
+}
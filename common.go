@@ -30,6 +30,12 @@ const BuiltInFunctionDCDTTransferRoleAddAddress = "DCDTTransferRoleAddAddress"
 // BuiltInFunctionDCDTTransferRoleDeleteAddress represents the defined built in function name for transfer role delete address
 const BuiltInFunctionDCDTTransferRoleDeleteAddress = "DCDTTransferRoleDeleteAddress"
 
+// BuiltInFunctionDeleteUserName represents the defined built in function name for delete user name
+const BuiltInFunctionDeleteUserName = "DeleteUserName"
+
+// UpgradeContractFunctionName represents the function name used by the data of the contract upgrade transactions
+const UpgradeContractFunctionName = "upgradeContract"
+
 // DCDTRoleBurnForAll represents the role for burn for all
 const DCDTRoleBurnForAll = "DCDTRoleBurnForAll"
 
@@ -16,3 +16,24 @@ var ErrTransfersNotIndexed = errors.New("unindexed transfers found")
 
 // ErrNilTransferIndexer signals that the provided transfer indexer is nil
 var ErrNilTransferIndexer = errors.New("nil NextOutputTransferIndexProvider")
+
+// ErrInvalidCodeMetadataLength signals that the code metadata does not have the expected length
+var ErrInvalidCodeMetadataLength = errors.New("invalid code metadata length")
+
+// ErrUnknownCodeMetadataBits signals that the code metadata holds flags which are not known
+var ErrUnknownCodeMetadataBits = errors.New("unknown code metadata bits")
+
+// ErrNilVMOutput signals that a nil vm output was provided
+var ErrNilVMOutput = errors.New("nil vm output")
+
+// ErrNilCanonicalEncoder signals that a nil canonical encoder was provided
+var ErrNilCanonicalEncoder = errors.New("nil canonical encoder")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidAddressLength signals that the address does not have the expected length
+var ErrInvalidAddressLength = errors.New("invalid address length")
@@ -7,6 +7,7 @@ replace github.com/gogo/protobuf => github.com/TerraDharitri/protobuf v0.0.1
 require (
 	github.com/TerraDharitri/drt-go-chain-core v1.0.1
 	github.com/TerraDharitri/drt-go-chain-logger v1.0.0
+	github.com/gogo/protobuf v1.3.2
 	github.com/mitchellh/mapstructure v1.4.1
 	github.com/stretchr/testify v1.7.0
 )
@@ -15,7 +16,6 @@ require (
 	github.com/btcsuite/btcd/btcutil v1.1.3 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/denisbrodbeck/machineid v1.0.1 // indirect
-	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/mr-tron/base58 v1.2.0 // indirect
 	github.com/pelletier/go-toml v1.9.3 // indirect
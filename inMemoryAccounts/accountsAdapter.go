@@ -0,0 +1,216 @@
+package inMemoryAccounts
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.AccountsAdapter = (*accountsAdapter)(nil)
+
+// journalEntry holds the state of an account before a save or a remove operation.
+// A nil previous account means that the account did not exist.
+type journalEntry struct {
+	address  string
+	previous *userAccount
+}
+
+type accountsAdapter struct {
+	mutState sync.RWMutex
+	hasher   hashing.Hasher
+	accounts map[string]*userAccount
+	codes    map[string][]byte
+	journal  []*journalEntry
+}
+
+// NewAccountsAdapter creates a new in-memory accounts adapter. Every save and remove operation is
+// journaled and can be reverted with RevertToSnapshot until the next Commit.
+func NewAccountsAdapter(hasher hashing.Hasher) (*accountsAdapter, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &accountsAdapter{
+		hasher:   hasher,
+		accounts: make(map[string]*userAccount),
+		codes:    make(map[string][]byte),
+		journal:  make([]*journalEntry, 0),
+	}, nil
+}
+
+// GetExistingAccount returns a copy of the account saved under the given address or an error if it does not exist
+func (adb *accountsAdapter) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	if len(address) == 0 {
+		return nil, ErrNilAddress
+	}
+
+	adb.mutState.RLock()
+	defer adb.mutState.RUnlock()
+
+	account, found := adb.accounts[string(address)]
+	if !found {
+		return nil, ErrAccountNotFound
+	}
+
+	return account.clone(), nil
+}
+
+// LoadAccount returns a copy of the account saved under the given address or a new empty account if it does not exist.
+// Changes made on the returned account are visible only after calling SaveAccount.
+func (adb *accountsAdapter) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	if len(address) == 0 {
+		return nil, ErrNilAddress
+	}
+
+	adb.mutState.RLock()
+	defer adb.mutState.RUnlock()
+
+	account, found := adb.accounts[string(address)]
+	if !found {
+		return newUserAccount(address), nil
+	}
+
+	return account.clone(), nil
+}
+
+// SaveAccount saves a copy of the provided account, journaling its previous state
+func (adb *accountsAdapter) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return ErrNilAccountHandler
+	}
+	userAcc, ok := account.(*userAccount)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	adb.mutState.Lock()
+	defer adb.mutState.Unlock()
+
+	toSave := userAcc.clone()
+	if len(toSave.code) > 0 {
+		toSave.codeHash = adb.hasher.Compute(string(toSave.code))
+		adb.codes[string(toSave.codeHash)] = cloneBytes(toSave.code)
+	}
+	toSave.rootHash = adb.hasher.Compute(string(toSave.dataTrie.serialize()))
+
+	userAcc.codeHash = cloneBytes(toSave.codeHash)
+	userAcc.rootHash = cloneBytes(toSave.rootHash)
+
+	adb.addJournalEntry(toSave.address)
+	adb.accounts[string(toSave.address)] = toSave
+
+	return nil
+}
+
+// RemoveAccount removes the account saved under the given address, journaling its previous state
+func (adb *accountsAdapter) RemoveAccount(address []byte) error {
+	if len(address) == 0 {
+		return ErrNilAddress
+	}
+
+	adb.mutState.Lock()
+	defer adb.mutState.Unlock()
+
+	_, found := adb.accounts[string(address)]
+	if !found {
+		return nil
+	}
+
+	adb.addJournalEntry(address)
+	delete(adb.accounts, string(address))
+
+	return nil
+}
+
+func (adb *accountsAdapter) addJournalEntry(address []byte) {
+	entry := &journalEntry{
+		address: string(address),
+	}
+	existing, found := adb.accounts[string(address)]
+	if found {
+		entry.previous = existing
+	}
+
+	adb.journal = append(adb.journal, entry)
+}
+
+// Commit clears the journal and returns the current root hash
+func (adb *accountsAdapter) Commit() ([]byte, error) {
+	adb.mutState.Lock()
+	defer adb.mutState.Unlock()
+
+	adb.journal = make([]*journalEntry, 0)
+
+	return adb.computeRootHash(), nil
+}
+
+// JournalLen returns the number of entries in the journal, to be used as a snapshot id
+func (adb *accountsAdapter) JournalLen() int {
+	adb.mutState.RLock()
+	defer adb.mutState.RUnlock()
+
+	return len(adb.journal)
+}
+
+// RevertToSnapshot undoes all the journaled operations done after the provided snapshot
+func (adb *accountsAdapter) RevertToSnapshot(snapshot int) error {
+	adb.mutState.Lock()
+	defer adb.mutState.Unlock()
+
+	if snapshot < 0 || snapshot > len(adb.journal) {
+		return ErrSnapshotValueOutOfBounds
+	}
+
+	for i := len(adb.journal) - 1; i >= snapshot; i-- {
+		entry := adb.journal[i]
+		if entry.previous == nil {
+			delete(adb.accounts, entry.address)
+			continue
+		}
+
+		adb.accounts[entry.address] = entry.previous
+	}
+	adb.journal = adb.journal[:snapshot]
+
+	return nil
+}
+
+// GetCode returns the code saved under the given code hash
+func (adb *accountsAdapter) GetCode(codeHash []byte) []byte {
+	adb.mutState.RLock()
+	defer adb.mutState.RUnlock()
+
+	return cloneBytes(adb.codes[string(codeHash)])
+}
+
+// RootHash returns a hash computed over all the accounts, in address order
+func (adb *accountsAdapter) RootHash() ([]byte, error) {
+	adb.mutState.RLock()
+	defer adb.mutState.RUnlock()
+
+	return adb.computeRootHash(), nil
+}
+
+func (adb *accountsAdapter) computeRootHash() []byte {
+	addresses := make([]string, 0, len(adb.accounts))
+	for address := range adb.accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	buff := &bytes.Buffer{}
+	for _, address := range addresses {
+		writeField(buff, adb.accounts[address].serialize())
+	}
+
+	return adb.hasher.Compute(buff.String())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adb *accountsAdapter) IsInterfaceNil() bool {
+	return adb == nil
+}
@@ -0,0 +1,329 @@
+package inMemoryAccounts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/builtInFunctions"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsAdapter(t *testing.T) *accountsAdapter {
+	adb, err := NewAccountsAdapter(sha256.NewSha256())
+	require.Nil(t, err)
+
+	return adb
+}
+
+func loadUserAccount(t *testing.T, adb vmcommon.AccountsAdapter, address []byte) vmcommon.UserAccountHandler {
+	account, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAcc, ok := account.(vmcommon.UserAccountHandler)
+	require.True(t, ok)
+
+	return userAcc
+}
+
+func TestNewAccountsAdapter(t *testing.T) {
+	t.Parallel()
+
+	adb, err := NewAccountsAdapter(nil)
+	assert.Nil(t, adb)
+	assert.Equal(t, ErrNilHasher, err)
+
+	adb, err = NewAccountsAdapter(sha256.NewSha256())
+	assert.Nil(t, err)
+	assert.False(t, adb.IsInterfaceNil())
+}
+
+func TestAccountsAdapter_LoadAndSaveAccount(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsAdapter(t)
+
+	_, err := adb.LoadAccount(nil)
+	assert.Equal(t, ErrNilAddress, err)
+	_, err = adb.GetExistingAccount([]byte("address"))
+	assert.Equal(t, ErrAccountNotFound, err)
+	assert.Equal(t, ErrNilAccountHandler, adb.SaveAccount(nil))
+	assert.Equal(t, ErrWrongTypeAssertion, adb.SaveAccount(mock.NewUserAccount([]byte("address"))))
+
+	acc := loadUserAccount(t, adb, []byte("address"))
+	_ = acc.AddToBalance(big.NewInt(10))
+	_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+
+	existing, err := adb.GetExistingAccount([]byte("address"))
+	assert.Equal(t, ErrAccountNotFound, err)
+	assert.Nil(t, existing)
+
+	err = adb.SaveAccount(acc)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, adb.JournalLen())
+	assert.NotEmpty(t, acc.GetRootHash())
+
+	existing, err = adb.GetExistingAccount([]byte("address"))
+	require.Nil(t, err)
+	reloaded := existing.(vmcommon.UserAccountHandler)
+	assert.Equal(t, big.NewInt(10), reloaded.GetBalance())
+	value, _, _ := reloaded.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+
+	_ = reloaded.AddToBalance(big.NewInt(5))
+	reloaded = loadUserAccount(t, adb, []byte("address"))
+	assert.Equal(t, big.NewInt(10), reloaded.GetBalance())
+}
+
+func TestAccountsAdapter_SaveAccountStoresCode(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsAdapter(t)
+	acc, _ := NewUserAccount([]byte("address"))
+	acc.SetCode([]byte("code"))
+	assert.Nil(t, acc.GetCodeHash())
+
+	err := adb.SaveAccount(acc)
+	require.Nil(t, err)
+	assert.Equal(t, sha256.NewSha256().Compute("code"), acc.GetCodeHash())
+	assert.Equal(t, []byte("code"), adb.GetCode(acc.GetCodeHash()))
+	assert.Nil(t, adb.GetCode([]byte("missing")))
+}
+
+func TestAccountsAdapter_RevertToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsAdapter(t)
+	acc := loadUserAccount(t, adb, []byte("first"))
+	_ = acc.AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	_, _ = adb.Commit()
+	assert.Equal(t, 0, adb.JournalLen())
+	rootHashAfterCommit, _ := adb.RootHash()
+
+	snapshot := adb.JournalLen()
+	acc = loadUserAccount(t, adb, []byte("first"))
+	_ = acc.AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(acc)
+	second := loadUserAccount(t, adb, []byte("second"))
+	_ = adb.SaveAccount(second)
+	_ = adb.RemoveAccount([]byte("first"))
+	assert.Equal(t, 3, adb.JournalLen())
+
+	rootHash, _ := adb.RootHash()
+	assert.NotEqual(t, rootHashAfterCommit, rootHash)
+
+	assert.Equal(t, ErrSnapshotValueOutOfBounds, adb.RevertToSnapshot(4))
+	assert.Equal(t, ErrSnapshotValueOutOfBounds, adb.RevertToSnapshot(-1))
+
+	err := adb.RevertToSnapshot(snapshot)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, adb.JournalLen())
+
+	_, err = adb.GetExistingAccount([]byte("second"))
+	assert.Equal(t, ErrAccountNotFound, err)
+	acc = loadUserAccount(t, adb, []byte("first"))
+	assert.Equal(t, big.NewInt(10), acc.GetBalance())
+
+	rootHash, _ = adb.RootHash()
+	assert.Equal(t, rootHashAfterCommit, rootHash)
+}
+
+func TestAccountsAdapter_RootHashIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	first := createAccountsAdapter(t)
+	second := createAccountsAdapter(t)
+
+	for _, address := range []string{"a", "b", "c"} {
+		acc := loadUserAccount(t, first, []byte(address))
+		_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte(address))
+		_ = first.SaveAccount(acc)
+	}
+	for _, address := range []string{"c", "a", "b"} {
+		acc := loadUserAccount(t, second, []byte(address))
+		_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte(address))
+		_ = second.SaveAccount(acc)
+	}
+
+	firstRootHash, _ := first.RootHash()
+	secondRootHash, _ := second.Commit()
+	assert.Equal(t, firstRootHash, secondRootHash)
+}
+
+func createGasMap() map[string]map[string]uint64 {
+	baseOperationCost := map[string]uint64{
+		"StorePerByte":      1,
+		"ReleasePerByte":    1,
+		"DataCopyPerByte":   1,
+		"PersistPerByte":    1,
+		"CompilePerByte":    1,
+		"AoTPreparePerByte": 1,
+	}
+	builtInCost := map[string]uint64{
+		"ChangeOwnerAddress":       1,
+		"ClaimDeveloperRewards":    1,
+		"SaveUserName":             1,
+		"SaveKeyValue":             1,
+		"DCDTTransfer":             1,
+		"DCDTBurn":                 1,
+		"DCDTLocalMint":            1,
+		"DCDTLocalBurn":            1,
+		"DCDTModifyRoyalties":      1,
+		"DCDTModifyCreator":        1,
+		"DCDTNFTCreate":            1,
+		"DCDTNFTRecreate":          1,
+		"DCDTNFTUpdate":            1,
+		"DCDTNFTAddQuantity":       1,
+		"DCDTNFTBurn":              1,
+		"DCDTNFTTransfer":          1,
+		"DCDTNFTChangeCreateOwner": 1,
+		"DCDTNFTMultiTransfer":     1,
+		"DCDTNFTAddURI":            1,
+		"DCDTNFTSetNewURIs":        1,
+		"DCDTNFTUpdateAttributes":  1,
+		"SetGuardian":              1,
+		"GuardAccount":             1,
+		"TrieLoadPerNode":          1,
+		"TrieStorePerNode":         1,
+	}
+
+	return map[string]map[string]uint64{
+		core.BaseOperationCostString: baseOperationCost,
+		core.BuiltInCostString:       builtInCost,
+	}
+}
+
+func createBuiltInFunctionContainer(t *testing.T, adb vmcommon.AccountsAdapter) vmcommon.BuiltInFunctionContainer {
+	args := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:                           createGasMap(),
+		MapDNSAddresses:                  make(map[string]struct{}),
+		MapDNSV2Addresses:                make(map[string]struct{}),
+		Marshalizer:                      &mock.MarshalizerMock{},
+		Accounts:                         adb,
+		ShardCoordinator:                 mock.NewMultiShardsCoordinatorMock(1),
+		EnableEpochsHandler:              &mock.EnableEpochsHandlerStub{},
+		GuardedAccountHandler:            &mock.GuardedAccountHandlerStub{},
+		MaxNumOfAddressesForTransferRole: 100,
+	}
+	creator, err := builtInFunctions.NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = creator.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+	err = creator.SetPayableHandler(&mock.PayableHandlerStub{})
+	require.Nil(t, err)
+
+	return creator.BuiltInFunctionContainer()
+}
+
+func TestAccountsAdapter_DCDTTransferEndToEnd(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	adb := createAccountsAdapter(t)
+	container := createBuiltInFunctionContainer(t, adb)
+	tokenID := []byte("TKN-abcdef")
+	tokenKey := append([]byte(core.ProtectedKeyPrefix+core.DCDTKeyIdentifier), tokenID...)
+	sender := []byte("sender-address-of-32-bytes-long!")
+	receiver := []byte("receiver-address-of-32-bytes-lon")
+
+	acntSnd := loadUserAccount(t, adb, sender)
+	balance, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(100)})
+	_ = acntSnd.AccountDataHandler().SaveKeyValue(tokenKey, balance)
+	_ = adb.SaveAccount(acntSnd)
+	_, _ = adb.Commit()
+
+	function, err := container.Get(core.BuiltInFunctionDCDTTransfer)
+	require.Nil(t, err)
+
+	acntSnd = loadUserAccount(t, adb, sender)
+	acntDst := loadUserAccount(t, adb, receiver)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 10,
+			Arguments:   [][]byte{tokenID, big.NewInt(40).Bytes()},
+		},
+		RecipientAddr: receiver,
+		Function:      core.BuiltInFunctionDCDTTransfer,
+	}
+	vmOutput, err := function.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	_ = adb.SaveAccount(acntSnd)
+	_ = adb.SaveAccount(acntDst)
+
+	checkBalance := func(address []byte, expected int64) {
+		value, _, _ := loadUserAccount(t, adb, address).AccountDataHandler().RetrieveValue(tokenKey)
+		dcdtData := &dcdt.DCDigitalToken{}
+		_ = marshaller.Unmarshal(dcdtData, value)
+		assert.Equal(t, big.NewInt(expected), dcdtData.Value)
+	}
+	checkBalance(sender, 60)
+	checkBalance(receiver, 40)
+
+	err = adb.RevertToSnapshot(0)
+	assert.Nil(t, err)
+	checkBalance(sender, 100)
+	_, err = adb.GetExistingAccount(receiver)
+	assert.Equal(t, ErrAccountNotFound, err)
+}
+
+func TestAccountsAdapter_DCDTNFTCreateEndToEnd(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	adb := createAccountsAdapter(t)
+	container := createBuiltInFunctionContainer(t, adb)
+	tokenID := []byte("NFT-abcdef")
+	creator := []byte("creator-address-of-32-bytes-long")
+
+	acnt := loadUserAccount(t, adb, creator)
+	roleKey := append([]byte(core.ProtectedKeyPrefix+core.DCDTRoleIdentifier+core.DCDTKeyIdentifier), tokenID...)
+	roles, _ := marshaller.Marshal(&dcdt.DCDTRoles{Roles: [][]byte{[]byte(core.DCDTRoleNFTCreate)}})
+	_ = acnt.AccountDataHandler().SaveKeyValue(roleKey, roles)
+	_ = adb.SaveAccount(acnt)
+
+	function, err := container.Get(core.BuiltInFunctionDCDTNFTCreate)
+	require.Nil(t, err)
+
+	acnt = loadUserAccount(t, adb, creator)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  creator,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				tokenID,
+				big.NewInt(1).Bytes(),
+				[]byte("name"),
+				big.NewInt(100).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: creator,
+		Function:      core.BuiltInFunctionDCDTNFTCreate,
+	}
+	vmOutput, err := function.ProcessBuiltinFunction(acnt, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, vmOutput.ReturnData)
+	_ = adb.SaveAccount(acnt)
+
+	nftKey := append([]byte(core.ProtectedKeyPrefix+core.DCDTKeyIdentifier), tokenID...)
+	nftKey = append(nftKey, big.NewInt(1).Bytes()...)
+	value, _, _ := loadUserAccount(t, adb, creator).AccountDataHandler().RetrieveValue(nftKey)
+	dcdtData := &dcdt.DCDigitalToken{}
+	err = marshaller.Unmarshal(dcdtData, value)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), dcdtData.Value)
+	assert.Equal(t, []byte("name"), dcdtData.TokenMetaData.Name)
+	assert.Equal(t, creator, dcdtData.TokenMetaData.Creator)
+}
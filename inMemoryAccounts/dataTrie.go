@@ -0,0 +1,114 @@
+package inMemoryAccounts
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.AccountDataHandler = (*dataTrie)(nil)
+var _ vmcommon.AccountDataIterator = (*dataTrie)(nil)
+
+// dataTrie is a map backed key-value storage for an in-memory account
+type dataTrie struct {
+	data map[string][]byte
+}
+
+func newDataTrie() *dataTrie {
+	return &dataTrie{
+		data: make(map[string][]byte),
+	}
+}
+
+// RetrieveValue returns the value saved under the provided key. A missing key yields a nil value.
+// The returned depth is always 0 as there is no underlying trie.
+func (dt *dataTrie) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, found := dt.data[string(key)]
+	if !found {
+		return nil, 0, nil
+	}
+
+	return cloneBytes(value), 0, nil
+}
+
+// SaveKeyValue saves the value under the provided key. An empty value deletes the key.
+func (dt *dataTrie) SaveKeyValue(key []byte, value []byte) error {
+	if len(key) == 0 {
+		return ErrEmptyKey
+	}
+
+	if len(value) == 0 {
+		delete(dt.data, string(key))
+		return nil
+	}
+
+	dt.data[string(key)] = cloneBytes(value)
+	return nil
+}
+
+// MigrateDataTrieLeaves does nothing as the in-memory storage does not hold versioned trie leaves
+func (dt *dataTrie) MigrateDataTrieLeaves(args vmcommon.ArgsMigrateDataTrieLeaves) error {
+	if check.IfNil(args.TrieMigrator) {
+		return ErrNilTrieMigrator
+	}
+
+	return nil
+}
+
+// IterateKeysWithPrefix calls the handler, in key order, for every key starting with the provided prefix
+func (dt *dataTrie) IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return ErrNilIterationHandler
+	}
+
+	for _, key := range dt.sortedKeys() {
+		if !bytes.HasPrefix([]byte(key), prefix) {
+			continue
+		}
+
+		shouldContinue := handler([]byte(key), cloneBytes(dt.data[key]))
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (dt *dataTrie) sortedKeys() []string {
+	keys := make([]string, 0, len(dt.data))
+	for key := range dt.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (dt *dataTrie) serialize() []byte {
+	buff := &bytes.Buffer{}
+	for _, key := range dt.sortedKeys() {
+		writeField(buff, []byte(key))
+		writeField(buff, dt.data[key])
+	}
+
+	return buff.Bytes()
+}
+
+func (dt *dataTrie) clone() *dataTrie {
+	clonedData := make(map[string][]byte, len(dt.data))
+	for key, value := range dt.data {
+		clonedData[key] = cloneBytes(value)
+	}
+
+	return &dataTrie{
+		data: clonedData,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dt *dataTrie) IsInterfaceNil() bool {
+	return dt == nil
+}
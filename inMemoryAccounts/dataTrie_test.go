@@ -0,0 +1,100 @@
+package inMemoryAccounts
+
+import (
+	"testing"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/dataTrieMigrator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataTrie_SaveKeyValueAndRetrieveValue(t *testing.T) {
+	t.Parallel()
+
+	dt := newDataTrie()
+	assert.False(t, dt.IsInterfaceNil())
+
+	err := dt.SaveKeyValue(nil, []byte("value"))
+	assert.Equal(t, ErrEmptyKey, err)
+
+	value, depth, err := dt.RetrieveValue([]byte("key"))
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, uint32(0), depth)
+
+	err = dt.SaveKeyValue([]byte("key"), []byte("value"))
+	assert.Nil(t, err)
+	value, _, err = dt.RetrieveValue([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	value[0] = 'X'
+	value, _, _ = dt.RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+
+	err = dt.SaveKeyValue([]byte("key"), nil)
+	assert.Nil(t, err)
+	value, _, _ = dt.RetrieveValue([]byte("key"))
+	assert.Nil(t, value)
+	assert.Equal(t, 0, len(dt.data))
+}
+
+func TestDataTrie_MigrateDataTrieLeaves(t *testing.T) {
+	t.Parallel()
+
+	dt := newDataTrie()
+	err := dt.MigrateDataTrieLeaves(vmcommon.ArgsMigrateDataTrieLeaves{})
+	assert.Equal(t, ErrNilTrieMigrator, err)
+
+	args := vmcommon.ArgsMigrateDataTrieLeaves{
+		TrieMigrator: dataTrieMigrator.NewDataTrieMigrator(dataTrieMigrator.ArgsNewDataTrieMigrator{}),
+	}
+	err = dt.MigrateDataTrieLeaves(args)
+	assert.Nil(t, err)
+}
+
+func TestDataTrie_SerializeIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	first := newDataTrie()
+	_ = first.SaveKeyValue([]byte("a"), []byte("1"))
+	_ = first.SaveKeyValue([]byte("b"), []byte("2"))
+
+	second := newDataTrie()
+	_ = second.SaveKeyValue([]byte("b"), []byte("2"))
+	_ = second.SaveKeyValue([]byte("a"), []byte("1"))
+
+	assert.Equal(t, first.serialize(), second.serialize())
+	assert.Equal(t, first.serialize(), first.clone().serialize())
+}
+
+func TestDataTrie_IterateKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	dt := newDataTrie()
+	err := dt.IterateKeysWithPrefix(nil, nil)
+	assert.Equal(t, ErrNilIterationHandler, err)
+
+	_ = dt.SaveKeyValue([]byte("prefix-b"), []byte("2"))
+	_ = dt.SaveKeyValue([]byte("prefix-a"), []byte("1"))
+	_ = dt.SaveKeyValue([]byte("other"), []byte("3"))
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	err = dt.IterateKeysWithPrefix([]byte("prefix-"), func(key []byte, value []byte) bool {
+		keys = append(keys, string(key))
+		values = append(values, string(value))
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"prefix-a", "prefix-b"}, keys)
+	assert.Equal(t, []string{"1", "2"}, values)
+
+	numCalls := 0
+	err = dt.IterateKeysWithPrefix(nil, func(_ []byte, _ []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
@@ -0,0 +1,42 @@
+package inMemoryAccounts
+
+import "errors"
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAddress signals that a nil or empty address has been provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrNilAccountHandler signals that a nil account handler has been provided
+var ErrNilAccountHandler = errors.New("nil account handler")
+
+// ErrAccountNotFound signals that the requested account does not exist
+var ErrAccountNotFound = errors.New("account not found")
+
+// ErrWrongTypeAssertion signals that a type assertion failed
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrSnapshotValueOutOfBounds signals that the snapshot value is out of the journal bounds
+var ErrSnapshotValueOutOfBounds = errors.New("snapshot value out of bounds")
+
+// ErrInsufficientFunds signals that the balance is not enough for the requested operation
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrNilValue signals that a nil value has been provided
+var ErrNilValue = errors.New("nil value")
+
+// ErrOperationNotPermitted signals that the operation is not permitted
+var ErrOperationNotPermitted = errors.New("operation not permitted")
+
+// ErrInvalidAddressLength signals that an address with an invalid length has been provided
+var ErrInvalidAddressLength = errors.New("invalid address length")
+
+// ErrEmptyKey signals that an empty key has been provided
+var ErrEmptyKey = errors.New("empty key")
+
+// ErrNilTrieMigrator signals that a nil data trie migrator has been provided
+var ErrNilTrieMigrator = errors.New("nil data trie migrator")
+
+// ErrNilIterationHandler signals that a nil iteration handler has been provided
+var ErrNilIterationHandler = errors.New("nil iteration handler")
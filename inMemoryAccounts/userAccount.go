@@ -0,0 +1,241 @@
+package inMemoryAccounts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+var _ vmcommon.UserAccountHandler = (*userAccount)(nil)
+
+type userAccount struct {
+	address         []byte
+	nonce           uint64
+	balance         *big.Int
+	developerReward *big.Int
+	code            []byte
+	codeHash        []byte
+	codeMetadata    []byte
+	rootHash        []byte
+	ownerAddress    []byte
+	userName        []byte
+	dataTrie        *dataTrie
+}
+
+// NewUserAccount creates a new, empty, in-memory user account
+func NewUserAccount(address []byte) (*userAccount, error) {
+	if len(address) == 0 {
+		return nil, ErrNilAddress
+	}
+
+	return newUserAccount(address), nil
+}
+
+func newUserAccount(address []byte) *userAccount {
+	return &userAccount{
+		address:         cloneBytes(address),
+		balance:         big.NewInt(0),
+		developerReward: big.NewInt(0),
+		dataTrie:        newDataTrie(),
+	}
+}
+
+// AddressBytes returns the address of the account
+func (a *userAccount) AddressBytes() []byte {
+	return a.address
+}
+
+// IncreaseNonce adds the given value to the current nonce
+func (a *userAccount) IncreaseNonce(value uint64) {
+	a.nonce += value
+}
+
+// GetNonce returns the nonce of the account
+func (a *userAccount) GetNonce() uint64 {
+	return a.nonce
+}
+
+// AddToBalance adds the given value to the balance. The resulting balance can not be negative.
+func (a *userAccount) AddToBalance(value *big.Int) error {
+	if value == nil {
+		return ErrNilValue
+	}
+
+	newBalance := big.NewInt(0).Add(a.balance, value)
+	if newBalance.Sign() < 0 {
+		return ErrInsufficientFunds
+	}
+
+	a.balance = newBalance
+	return nil
+}
+
+// SubFromBalance subtracts the given value from the balance. The resulting balance can not be negative.
+func (a *userAccount) SubFromBalance(value *big.Int) error {
+	if value == nil {
+		return ErrNilValue
+	}
+
+	newBalance := big.NewInt(0).Sub(a.balance, value)
+	if newBalance.Sign() < 0 {
+		return ErrInsufficientFunds
+	}
+
+	a.balance = newBalance
+	return nil
+}
+
+// GetBalance returns the balance of the account
+func (a *userAccount) GetBalance() *big.Int {
+	return big.NewInt(0).Set(a.balance)
+}
+
+// AddToDeveloperReward adds the given value to the developer reward
+func (a *userAccount) AddToDeveloperReward(value *big.Int) {
+	if value == nil {
+		return
+	}
+
+	a.developerReward = big.NewInt(0).Add(a.developerReward, value)
+}
+
+// ClaimDeveloperRewards resets the developer reward and returns the claimed value. Only the owner can claim.
+func (a *userAccount) ClaimDeveloperRewards(sender []byte) (*big.Int, error) {
+	if !bytes.Equal(sender, a.ownerAddress) {
+		return nil, ErrOperationNotPermitted
+	}
+
+	oldValue := big.NewInt(0).Set(a.developerReward)
+	a.developerReward = big.NewInt(0)
+
+	return oldValue, nil
+}
+
+// GetDeveloperReward returns the accumulated developer reward
+func (a *userAccount) GetDeveloperReward() *big.Int {
+	return big.NewInt(0).Set(a.developerReward)
+}
+
+// ChangeOwnerAddress changes the owner address. Only the current owner can change it.
+func (a *userAccount) ChangeOwnerAddress(sender []byte, newAddress []byte) error {
+	if !bytes.Equal(sender, a.ownerAddress) {
+		return ErrOperationNotPermitted
+	}
+	if len(newAddress) != len(a.address) {
+		return ErrInvalidAddressLength
+	}
+
+	a.ownerAddress = cloneBytes(newAddress)
+	return nil
+}
+
+// SetOwnerAddress sets the owner address
+func (a *userAccount) SetOwnerAddress(address []byte) {
+	a.ownerAddress = cloneBytes(address)
+}
+
+// GetOwnerAddress returns the owner address
+func (a *userAccount) GetOwnerAddress() []byte {
+	return a.ownerAddress
+}
+
+// SetUserName sets the user name
+func (a *userAccount) SetUserName(userName []byte) {
+	a.userName = cloneBytes(userName)
+}
+
+// GetUserName returns the user name
+func (a *userAccount) GetUserName() []byte {
+	return a.userName
+}
+
+// SetCode sets the code of the account. The code hash is computed when the account is saved.
+func (a *userAccount) SetCode(code []byte) {
+	a.code = cloneBytes(code)
+	a.codeHash = nil
+}
+
+// GetCode returns the code of the account
+func (a *userAccount) GetCode() []byte {
+	return a.code
+}
+
+// GetCodeHash returns the code hash of the account, as computed on the last save
+func (a *userAccount) GetCodeHash() []byte {
+	return a.codeHash
+}
+
+// SetCodeMetadata sets the code metadata
+func (a *userAccount) SetCodeMetadata(codeMetadata []byte) {
+	a.codeMetadata = cloneBytes(codeMetadata)
+}
+
+// GetCodeMetadata returns the code metadata
+func (a *userAccount) GetCodeMetadata() []byte {
+	return a.codeMetadata
+}
+
+// GetRootHash returns the hash of the account storage, as computed on the last save
+func (a *userAccount) GetRootHash() []byte {
+	return a.rootHash
+}
+
+// AccountDataHandler returns the account storage handler
+func (a *userAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return a.dataTrie
+}
+
+func (a *userAccount) serialize() []byte {
+	buff := &bytes.Buffer{}
+	writeField(buff, a.address)
+	writeField(buff, big.NewInt(0).SetUint64(a.nonce).Bytes())
+	writeField(buff, a.balance.Bytes())
+	writeField(buff, a.developerReward.Bytes())
+	writeField(buff, a.codeHash)
+	writeField(buff, a.codeMetadata)
+	writeField(buff, a.rootHash)
+	writeField(buff, a.ownerAddress)
+	writeField(buff, a.userName)
+
+	return buff.Bytes()
+}
+
+func (a *userAccount) clone() *userAccount {
+	return &userAccount{
+		address:         cloneBytes(a.address),
+		nonce:           a.nonce,
+		balance:         big.NewInt(0).Set(a.balance),
+		developerReward: big.NewInt(0).Set(a.developerReward),
+		code:            cloneBytes(a.code),
+		codeHash:        cloneBytes(a.codeHash),
+		codeMetadata:    cloneBytes(a.codeMetadata),
+		rootHash:        cloneBytes(a.rootHash),
+		ownerAddress:    cloneBytes(a.ownerAddress),
+		userName:        cloneBytes(a.userName),
+		dataTrie:        a.dataTrie.clone(),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *userAccount) IsInterfaceNil() bool {
+	return a == nil
+}
+
+func writeField(buff *bytes.Buffer, field []byte) {
+	lenBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(lenBytes, uint32(len(field)))
+	buff.Write(lenBytes)
+	buff.Write(field)
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	clone := make([]byte, len(b))
+	copy(clone, b)
+	return clone
+}
@@ -0,0 +1,93 @@
+package inMemoryAccounts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserAccount(t *testing.T) {
+	t.Parallel()
+
+	acc, err := NewUserAccount(nil)
+	assert.Nil(t, acc)
+	assert.Equal(t, ErrNilAddress, err)
+
+	acc, err = NewUserAccount([]byte("address"))
+	require.Nil(t, err)
+	assert.False(t, acc.IsInterfaceNil())
+	assert.Equal(t, []byte("address"), acc.AddressBytes())
+	assert.Equal(t, big.NewInt(0), acc.GetBalance())
+	assert.Equal(t, big.NewInt(0), acc.GetDeveloperReward())
+	assert.False(t, acc.AccountDataHandler().IsInterfaceNil())
+}
+
+func TestUserAccount_Balance(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := NewUserAccount([]byte("address"))
+	assert.Equal(t, ErrNilValue, acc.AddToBalance(nil))
+	assert.Equal(t, ErrNilValue, acc.SubFromBalance(nil))
+
+	assert.Nil(t, acc.AddToBalance(big.NewInt(10)))
+	assert.Nil(t, acc.SubFromBalance(big.NewInt(3)))
+	assert.Equal(t, big.NewInt(7), acc.GetBalance())
+
+	assert.Equal(t, ErrInsufficientFunds, acc.SubFromBalance(big.NewInt(8)))
+	assert.Equal(t, ErrInsufficientFunds, acc.AddToBalance(big.NewInt(-8)))
+	assert.Equal(t, big.NewInt(7), acc.GetBalance())
+
+	acc.GetBalance().SetInt64(100)
+	assert.Equal(t, big.NewInt(7), acc.GetBalance())
+}
+
+func TestUserAccount_OwnerAndDeveloperRewards(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := NewUserAccount([]byte("address"))
+	acc.SetOwnerAddress([]byte("owner00"))
+	acc.AddToDeveloperReward(big.NewInt(5))
+
+	_, err := acc.ClaimDeveloperRewards([]byte("other"))
+	assert.Equal(t, ErrOperationNotPermitted, err)
+
+	reward, err := acc.ClaimDeveloperRewards([]byte("owner00"))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(5), reward)
+	assert.Equal(t, big.NewInt(0), acc.GetDeveloperReward())
+
+	err = acc.ChangeOwnerAddress([]byte("other"), []byte("owner01"))
+	assert.Equal(t, ErrOperationNotPermitted, err)
+	err = acc.ChangeOwnerAddress([]byte("owner00"), []byte("short"))
+	assert.Equal(t, ErrInvalidAddressLength, err)
+	err = acc.ChangeOwnerAddress([]byte("owner00"), []byte("owner01"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("owner01"), acc.GetOwnerAddress())
+}
+
+func TestUserAccount_Clone(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := NewUserAccount([]byte("address"))
+	acc.IncreaseNonce(2)
+	acc.SetUserName([]byte("name"))
+	acc.SetCode([]byte("code"))
+	acc.SetCodeMetadata([]byte{1, 0})
+	_ = acc.AddToBalance(big.NewInt(10))
+	_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+
+	clone := acc.clone()
+	assert.Equal(t, acc.serialize(), clone.serialize())
+	assert.Equal(t, acc.GetCode(), clone.GetCode())
+
+	_ = clone.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("other"))
+	clone.IncreaseNonce(1)
+	_ = clone.AddToBalance(big.NewInt(1))
+
+	value, _, _ := acc.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+	assert.Equal(t, uint64(2), acc.GetNonce())
+	assert.Equal(t, big.NewInt(10), acc.GetBalance())
+}
@@ -0,0 +1,67 @@
+package inputValidator
+
+import (
+	"math/big"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// The checks below are the ones done by every built-in function before processing. They return the errors of this
+// package unwrapped, as the built-in functions errors are part of the execution output.
+
+// CheckNoCallValue returns an error if the input is nil or if it holds a call value
+func CheckNoCallValue(vmInput *vmcommon.ContractCallInput) error {
+	if vmInput == nil {
+		return ErrNilVMInput
+	}
+
+	return CheckNoValue(vmInput.CallValue)
+}
+
+// CheckNoValue returns an error if the value is nil or not zero, for the checks done outside of a call input
+func CheckNoValue(value *big.Int) error {
+	if value == nil {
+		return ErrNilCallValue
+	}
+	if value.Sign() != 0 {
+		return ErrCallValueNotAllowed
+	}
+
+	return nil
+}
+
+// CheckMinNumArguments returns an error if the input holds less than the provided number of arguments
+func CheckMinNumArguments(vmInput *vmcommon.ContractCallInput, minNumArguments int) error {
+	if vmInput == nil {
+		return ErrNilVMInput
+	}
+	if len(vmInput.Arguments) < minNumArguments {
+		return ErrInvalidArguments
+	}
+
+	return nil
+}
+
+// CheckNumArguments returns an error if the input does not hold exactly the provided number of arguments
+func CheckNumArguments(vmInput *vmcommon.ContractCallInput, numArguments int) error {
+	if vmInput == nil {
+		return ErrNilVMInput
+	}
+	if len(vmInput.Arguments) != numArguments {
+		return ErrInvalidArguments
+	}
+
+	return nil
+}
+
+// CheckGasProvided returns an error if the input is provided with less gas than the cost
+func CheckGasProvided(vmInput *vmcommon.ContractCallInput, gasCost uint64) error {
+	if vmInput == nil {
+		return ErrNilVMInput
+	}
+	if vmInput.GasProvided < gasCost {
+		return ErrNotEnoughGas
+	}
+
+	return nil
+}
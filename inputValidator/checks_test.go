@@ -0,0 +1,75 @@
+package inputValidator
+
+import (
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createCallInput() *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller"),
+			Arguments:   [][]byte{[]byte("arg1"), []byte("arg2")},
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+		},
+		RecipientAddr: []byte("recipient"),
+		Function:      "function",
+	}
+}
+
+func TestCheckNoCallValue(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ErrNilVMInput, CheckNoCallValue(nil))
+
+	input := createCallInput()
+	input.CallValue = nil
+	require.Equal(t, ErrNilCallValue, CheckNoCallValue(input))
+
+	input.CallValue = big.NewInt(1)
+	require.Equal(t, ErrCallValueNotAllowed, CheckNoCallValue(input))
+
+	input.CallValue = big.NewInt(-1)
+	require.Equal(t, ErrCallValueNotAllowed, CheckNoCallValue(input))
+
+	require.Nil(t, CheckNoCallValue(createCallInput()))
+}
+
+func TestCheckNoValue(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ErrNilCallValue, CheckNoValue(nil))
+	require.Equal(t, ErrCallValueNotAllowed, CheckNoValue(big.NewInt(1)))
+	require.Equal(t, ErrCallValueNotAllowed, CheckNoValue(big.NewInt(-1)))
+	require.Nil(t, CheckNoValue(big.NewInt(0)))
+}
+
+func TestCheckMinNumArguments(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ErrNilVMInput, CheckMinNumArguments(nil, 1))
+	require.Equal(t, ErrInvalidArguments, CheckMinNumArguments(createCallInput(), 3))
+	require.Nil(t, CheckMinNumArguments(createCallInput(), 2))
+	require.Nil(t, CheckMinNumArguments(createCallInput(), 0))
+}
+
+func TestCheckNumArguments(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ErrNilVMInput, CheckNumArguments(nil, 1))
+	require.Equal(t, ErrInvalidArguments, CheckNumArguments(createCallInput(), 1))
+	require.Equal(t, ErrInvalidArguments, CheckNumArguments(createCallInput(), 3))
+	require.Nil(t, CheckNumArguments(createCallInput(), 2))
+}
+
+func TestCheckGasProvided(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ErrNilVMInput, CheckGasProvided(nil, 1))
+	require.Equal(t, ErrNotEnoughGas, CheckGasProvided(createCallInput(), 101))
+	require.Nil(t, CheckGasProvided(createCallInput(), 100))
+}
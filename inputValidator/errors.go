@@ -0,0 +1,45 @@
+package inputValidator
+
+import "errors"
+
+// ErrNilVMInput signals that provided vm input is nil
+var ErrNilVMInput = errors.New("nil vm input")
+
+// ErrNilCallValue signals that the call value is nil
+var ErrNilCallValue = errors.New("nil value")
+
+// ErrCallValueNotAllowed signals that builtin function was called with value that is not allowed
+var ErrCallValueNotAllowed = errors.New("built in function called with tx value is not allowed")
+
+// ErrInvalidArguments signals that invalid arguments were given to process built-in function
+var ErrInvalidArguments = errors.New("invalid arguments to process built-in function")
+
+// ErrNotEnoughGas signals that not enough gas has been provided
+var ErrNotEnoughGas = errors.New("not enough gas was sent in the transaction")
+
+// ErrNegativeValue signals that a negative value has been detected and it is not allowed
+var ErrNegativeValue = errors.New("negative value")
+
+// ErrInvalidTokenID signals that invalid tokenID was provided
+var ErrInvalidTokenID = errors.New("invalid tokenID")
+
+// ErrGasLockedExceedsGasProvided signals that the gas locked for the callback is greater than the gas provided
+var ErrGasLockedExceedsGasProvided = errors.New("gas locked exceeds gas provided")
+
+// ErrNilDCDTTransfer signals that a nil dcdt transfer was provided
+var ErrNilDCDTTransfer = errors.New("nil dcdt transfer")
+
+// ErrAsyncArgumentsNotAllowed signals async arguments provided for a call type which is not asynchronous
+var ErrAsyncArgumentsNotAllowed = errors.New("async arguments not allowed for the call type")
+
+// ErrEmptyCallID signals that the async arguments do not hold the call ID
+var ErrEmptyCallID = errors.New("empty call ID")
+
+// ErrEmptyAddress signals that an empty address was provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrEmptyFunction signals that an empty function name was provided
+var ErrEmptyFunction = errors.New("empty function")
+
+// ErrEmptyContractCode signals that an empty contract code was provided
+var ErrEmptyContractCode = errors.New("empty contract code")
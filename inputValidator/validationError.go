@@ -0,0 +1,26 @@
+package inputValidator
+
+import "fmt"
+
+// ValidationError points to the vm input field which is not valid, Err being one of the errors of this package
+type ValidationError struct {
+	Field string
+	Err   error
+}
+
+// Error returns the error description
+func (ve *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", ve.Field, ve.Err)
+}
+
+// Unwrap returns the error of the failed check, so errors.Is can be used against the errors of this package
+func (ve *ValidationError) Unwrap() error {
+	return ve.Err
+}
+
+func newValidationError(field string, err error) *ValidationError {
+	return &ValidationError{
+		Field: field,
+		Err:   err,
+	}
+}
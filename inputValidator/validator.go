@@ -0,0 +1,111 @@
+package inputValidator
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// ValidateVMInput checks the fields common to all the calls, returning a *ValidationError pointing to the first
+// invalid field: the call value must be set and not negative, the gas locked must not exceed the gas provided,
+// the dcdt transfers must hold valid token identifiers and not negative values, while the async arguments are
+// allowed only for the asynchronous calls and callbacks
+func ValidateVMInput(vmInput *vmcommon.VMInput) error {
+	if vmInput == nil {
+		return ErrNilVMInput
+	}
+	if vmInput.CallValue == nil {
+		return newValidationError("CallValue", ErrNilCallValue)
+	}
+	if vmInput.CallValue.Sign() < 0 {
+		return newValidationError("CallValue", ErrNegativeValue)
+	}
+	if vmInput.GasLocked > vmInput.GasProvided {
+		return newValidationError("GasLocked", ErrGasLockedExceedsGasProvided)
+	}
+
+	for i, transfer := range vmInput.DCDTTransfers {
+		err := validateDCDTTransfer(transfer)
+		if err != nil {
+			return newValidationError(fmt.Sprintf("DCDTTransfers[%d]", i), err)
+		}
+	}
+
+	return validateAsyncArguments(vmInput)
+}
+
+func validateDCDTTransfer(transfer *vmcommon.DCDTTransfer) error {
+	if transfer == nil {
+		return ErrNilDCDTTransfer
+	}
+	if transfer.DCDTValue == nil {
+		return ErrNilCallValue
+	}
+	if transfer.DCDTValue.Sign() < 0 {
+		return ErrNegativeValue
+	}
+	if !vmcommon.ValidateToken(transfer.DCDTTokenName) {
+		return ErrInvalidTokenID
+	}
+
+	return nil
+}
+
+func validateAsyncArguments(vmInput *vmcommon.VMInput) error {
+	if vmInput.AsyncArguments == nil {
+		return nil
+	}
+
+	isAsync := vmInput.CallType == vm.AsynchronousCall || vmInput.CallType == vm.AsynchronousCallBack
+	if !isAsync {
+		return newValidationError("AsyncArguments", fmt.Errorf("%w: %s", ErrAsyncArgumentsNotAllowed, vmInput.CallType.ToString()))
+	}
+	if len(vmInput.AsyncArguments.CallID) == 0 {
+		return newValidationError("AsyncArguments.CallID", ErrEmptyCallID)
+	}
+
+	return nil
+}
+
+// ValidateContractCallInput checks the common fields, the recipient and the function of the contract call input
+func ValidateContractCallInput(input *vmcommon.ContractCallInput) error {
+	if input == nil {
+		return ErrNilVMInput
+	}
+
+	err := ValidateVMInput(&input.VMInput)
+	if err != nil {
+		return err
+	}
+	if len(input.RecipientAddr) == 0 {
+		return newValidationError("RecipientAddr", ErrEmptyAddress)
+	}
+	if len(input.Function) == 0 {
+		return newValidationError("Function", ErrEmptyFunction)
+	}
+
+	return nil
+}
+
+// ValidateContractCreateInput checks the common fields, the code and the code metadata of the contract create input
+func ValidateContractCreateInput(input *vmcommon.ContractCreateInput) error {
+	if input == nil {
+		return ErrNilVMInput
+	}
+
+	err := ValidateVMInput(&input.VMInput)
+	if err != nil {
+		return err
+	}
+	if len(input.ContractCode) == 0 {
+		return newValidationError("ContractCode", ErrEmptyContractCode)
+	}
+
+	err = vmcommon.CheckCodeMetadataBytes(input.ContractCodeMetadata)
+	if err != nil {
+		return newValidationError("ContractCodeMetadata", err)
+	}
+
+	return nil
+}
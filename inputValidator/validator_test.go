@@ -0,0 +1,140 @@
+package inputValidator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func requireValidationError(t *testing.T, err error, expectedField string, expectedErr error) {
+	validationError, ok := err.(*ValidationError)
+	require.True(t, ok, "expected a validation error, got %v", err)
+	require.Equal(t, expectedField, validationError.Field)
+	require.True(t, errors.Is(err, expectedErr))
+}
+
+func TestValidateVMInput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil input should error", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, ErrNilVMInput, ValidateVMInput(nil))
+	})
+	t.Run("invalid call value should error", func(t *testing.T) {
+		t.Parallel()
+
+		input := createCallInput()
+		input.CallValue = nil
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "CallValue", ErrNilCallValue)
+
+		input.CallValue = big.NewInt(-1)
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "CallValue", ErrNegativeValue)
+	})
+	t.Run("gas locked exceeding gas provided should error", func(t *testing.T) {
+		t.Parallel()
+
+		input := createCallInput()
+		input.GasLocked = input.GasProvided + 1
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "GasLocked", ErrGasLockedExceedsGasProvided)
+
+		input.GasLocked = input.GasProvided
+		require.Nil(t, ValidateVMInput(&input.VMInput))
+	})
+	t.Run("invalid dcdt transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		validTransfer := &vmcommon.DCDTTransfer{DCDTValue: big.NewInt(10), DCDTTokenName: []byte("TKN-abcdef")}
+		input := createCallInput()
+
+		input.DCDTTransfers = []*vmcommon.DCDTTransfer{validTransfer, nil}
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "DCDTTransfers[1]", ErrNilDCDTTransfer)
+
+		input.DCDTTransfers = []*vmcommon.DCDTTransfer{{DCDTTokenName: []byte("TKN-abcdef")}}
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "DCDTTransfers[0]", ErrNilCallValue)
+
+		input.DCDTTransfers = []*vmcommon.DCDTTransfer{{DCDTValue: big.NewInt(-1), DCDTTokenName: []byte("TKN-abcdef")}}
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "DCDTTransfers[0]", ErrNegativeValue)
+
+		input.DCDTTransfers = []*vmcommon.DCDTTransfer{validTransfer, {DCDTValue: big.NewInt(1), DCDTTokenName: []byte("tkn-abcdef")}}
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "DCDTTransfers[1]", ErrInvalidTokenID)
+
+		input.DCDTTransfers = []*vmcommon.DCDTTransfer{validTransfer}
+		require.Nil(t, ValidateVMInput(&input.VMInput))
+	})
+	t.Run("async arguments should match the call type", func(t *testing.T) {
+		t.Parallel()
+
+		input := createCallInput()
+		input.CallType = vm.DirectCall
+		input.AsyncArguments = &vmcommon.AsyncArguments{CallID: []byte("call id")}
+		err := ValidateVMInput(&input.VMInput)
+		requireValidationError(t, err, "AsyncArguments", ErrAsyncArgumentsNotAllowed)
+		require.Contains(t, err.Error(), vm.DirectCallStr)
+
+		input.CallType = vm.AsynchronousCall
+		require.Nil(t, ValidateVMInput(&input.VMInput))
+
+		input.CallType = vm.AsynchronousCallBack
+		require.Nil(t, ValidateVMInput(&input.VMInput))
+
+		input.AsyncArguments.CallID = nil
+		requireValidationError(t, ValidateVMInput(&input.VMInput), "AsyncArguments.CallID", ErrEmptyCallID)
+	})
+}
+
+func TestValidateContractCallInput(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ErrNilVMInput, ValidateContractCallInput(nil))
+
+	input := createCallInput()
+	input.CallValue = nil
+	requireValidationError(t, ValidateContractCallInput(input), "CallValue", ErrNilCallValue)
+
+	input = createCallInput()
+	input.RecipientAddr = nil
+	requireValidationError(t, ValidateContractCallInput(input), "RecipientAddr", ErrEmptyAddress)
+
+	input = createCallInput()
+	input.Function = ""
+	requireValidationError(t, ValidateContractCallInput(input), "Function", ErrEmptyFunction)
+
+	require.Nil(t, ValidateContractCallInput(createCallInput()))
+}
+
+func TestValidateContractCreateInput(t *testing.T) {
+	t.Parallel()
+
+	createInput := func() *vmcommon.ContractCreateInput {
+		return &vmcommon.ContractCreateInput{
+			VMInput:              createCallInput().VMInput,
+			ContractCode:         []byte("code"),
+			ContractCodeMetadata: []byte{vmcommon.MetadataUpgradeable, vmcommon.MetadataPayable},
+		}
+	}
+
+	require.Equal(t, ErrNilVMInput, ValidateContractCreateInput(nil))
+
+	input := createInput()
+	input.GasLocked = input.GasProvided + 1
+	requireValidationError(t, ValidateContractCreateInput(input), "GasLocked", ErrGasLockedExceedsGasProvided)
+
+	input = createInput()
+	input.ContractCode = nil
+	requireValidationError(t, ValidateContractCreateInput(input), "ContractCode", ErrEmptyContractCode)
+
+	input = createInput()
+	input.ContractCodeMetadata = []byte{1}
+	requireValidationError(t, ValidateContractCreateInput(input), "ContractCodeMetadata", vmcommon.ErrInvalidCodeMetadataLength)
+
+	input = createInput()
+	input.ContractCodeMetadata = []byte{2, 0}
+	requireValidationError(t, ValidateContractCreateInput(input), "ContractCodeMetadata", vmcommon.ErrUnknownCodeMetadataBits)
+
+	require.Nil(t, ValidateContractCreateInput(createInput()))
+}
@@ -304,6 +304,13 @@ type AccountDataHandler interface {
 	IsInterfaceNil() bool
 }
 
+// AccountDataIterator is an optional capability of an AccountDataHandler, able to iterate over the stored keys
+type AccountDataIterator interface {
+	// IterateKeysWithPrefix calls the handler, in key order, for every stored key starting with the provided prefix.
+	// The iteration stops when the handler returns false.
+	IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error
+}
+
 // AccountHandler models a state account, which can journalize and revert
 // It knows about code and data, as data structures not hashes
 type AccountHandler interface {
@@ -0,0 +1,21 @@
+package mock
+
+import vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+
+// OutputCheckerStub -
+type OutputCheckerStub struct {
+	VerifyCalled func(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error
+}
+
+// Verify -
+func (stub *OutputCheckerStub) Verify(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error {
+	if stub.VerifyCalled != nil {
+		return stub.VerifyCalled(input, vmOutput)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *OutputCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
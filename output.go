@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
 )
@@ -226,6 +227,125 @@ func (vmOutput *VMOutput) ReindexTransfers(nextIndexProvider NextOutputTransferI
 	return nil
 }
 
+// Merge appends the output of an execution chained after the current one, as a smart contract call executed after a
+// built-in function, so the chain produces a single output:
+//   - the return data and the logs of the other output are appended, keeping the execution order
+//   - the return code, the return message and the remaining gas are the ones of the other output, as it is the last
+//     execution of the chain, started with the gas remaining after the current one
+//   - the gas refunds are summed
+//   - the output transfers of the other output are reindexed through the provided index provider and appended to the
+//     ones of the same account, while the gas used, the balance deltas and the storage bytes of an account are summed
+//   - the storage updates, the code and the nonce of the other output replace the current ones, as in MergeOutputAccounts
+//   - the deleted and touched accounts are appended, without duplicates
+//
+// The other output must not be used after the merge, as its transfers are reindexed and its accounts are reused.
+func (vmOutput *VMOutput) Merge(other *VMOutput, nextIndexProvider NextOutputTransferIndexProvider) error {
+	if other == nil {
+		return ErrNilVMOutput
+	}
+
+	err := other.ReindexTransfers(nextIndexProvider)
+	if err != nil {
+		return err
+	}
+
+	mergedAccounts := make(map[string]*OutputAccount, len(vmOutput.OutputAccounts)+len(other.OutputAccounts))
+	for address, outAcc := range vmOutput.OutputAccounts {
+		mergedAccounts[address] = outAcc
+	}
+	for address, outAcc := range other.OutputAccounts {
+		currentAcc, found := mergedAccounts[address]
+		if !found {
+			mergedAccounts[address] = outAcc
+			continue
+		}
+
+		mergedAccounts[address], err = mergeChainedOutputAccounts(currentAcc, outAcc)
+		if err != nil {
+			return err
+		}
+	}
+
+	vmOutput.OutputAccounts = mergedAccounts
+	vmOutput.ReturnData = append(vmOutput.ReturnData, other.ReturnData...)
+	vmOutput.ReturnCode = other.ReturnCode
+	vmOutput.ReturnMessage = other.ReturnMessage
+	vmOutput.GasRemaining = other.GasRemaining
+	vmOutput.GasRefund = addGasRefunds(vmOutput.GasRefund, other.GasRefund)
+	vmOutput.Logs = append(vmOutput.Logs, other.Logs...)
+	vmOutput.DeletedAccounts = appendUniqueAddresses(vmOutput.DeletedAccounts, other.DeletedAccounts)
+	vmOutput.TouchedAccounts = appendUniqueAddresses(vmOutput.TouchedAccounts, other.TouchedAccounts)
+
+	return nil
+}
+
+// mergeChainedOutputAccounts returns a new output account holding the changes of both accounts, without modifying them
+func mergeChainedOutputAccounts(current *OutputAccount, other *OutputAccount) (*OutputAccount, error) {
+	gasUsed, err := core.SafeAddUint64(current.GasUsed, other.GasUsed)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *current
+	merged.StorageUpdates = make(map[string]*StorageUpdate, len(current.StorageUpdates)+len(other.StorageUpdates))
+	merged.MergeStorageUpdates(current)
+	if current.BalanceDelta != nil {
+		merged.BalanceDelta = big.NewInt(0).Set(current.BalanceDelta)
+	}
+	merged.OutputTransfers = make([]OutputTransfer, 0, len(current.OutputTransfers)+len(other.OutputTransfers))
+	merged.OutputTransfers = append(merged.OutputTransfers, current.OutputTransfers...)
+
+	otherWithoutTransfers := *other
+	otherWithoutTransfers.OutputTransfers = nil
+	merged.MergeOutputAccounts(&otherWithoutTransfers)
+
+	merged.OutputTransfers = append(merged.OutputTransfers, other.OutputTransfers...)
+	merged.GasUsed = gasUsed
+	merged.BytesAddedToStorage += other.BytesAddedToStorage
+	merged.BytesDeletedFromStorage += other.BytesDeletedFromStorage
+	merged.BytesConsumedByTxAsNetworking += other.BytesConsumedByTxAsNetworking
+
+	return &merged, nil
+}
+
+func addGasRefunds(current *big.Int, other *big.Int) *big.Int {
+	if current == nil && other == nil {
+		return nil
+	}
+
+	sum := big.NewInt(0)
+	if current != nil {
+		sum.Add(sum, current)
+	}
+	if other != nil {
+		sum.Add(sum, other)
+	}
+
+	return sum
+}
+
+func appendUniqueAddresses(addresses [][]byte, newAddresses [][]byte) [][]byte {
+	if len(addresses)+len(newAddresses) == 0 {
+		return addresses
+	}
+
+	existing := make(map[string]struct{}, len(addresses)+len(newAddresses))
+	uniqueAddresses := make([][]byte, 0, len(addresses)+len(newAddresses))
+	for _, list := range [][][]byte{addresses, newAddresses} {
+		for _, address := range list {
+			_, found := existing[string(address)]
+			if found {
+				continue
+			}
+
+			existing[string(address)] = struct{}{}
+			uniqueAddresses = append(uniqueAddresses, address)
+		}
+	}
+
+	return uniqueAddresses
+}
+
 // MergeOutputAccounts merges the given account into the current one
 func (o *OutputAccount) MergeOutputAccounts(outAcc *OutputAccount) {
 	if len(outAcc.Address) != 0 {
@@ -0,0 +1,15 @@
+package outputChecker
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilVMInput signals that a nil vm input has been provided
+var ErrNilVMInput = errors.New("nil vm input")
+
+// ErrNilVMOutput signals that a nil vm output has been provided
+var ErrNilVMOutput = errors.New("nil vm output")
+
+// ErrInvariantsViolated signals that the vm output breaks at least one of the checked invariants
+var ErrInvariantsViolated = errors.New("vm output invariants violated")
@@ -0,0 +1,243 @@
+package outputChecker
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	logevents "github.com/TerraDharitri/drt-go-chain-vm-common/parsers/logEvents"
+)
+
+// ArgsNewOutputChecker defines the arguments needed to create a new output checker
+type ArgsNewOutputChecker struct {
+	Marshalizer vmcommon.Marshalizer
+	// CheckBalanceConservation enables the check that the balance deltas of the output accounts sum up to zero. It
+	// only holds when every party of the REWA transfers is found in the output, so it is not set by default.
+	CheckBalanceConservation bool
+}
+
+type logsDecoder interface {
+	Decode(entry *vmcommon.LogEntry) (logevents.Event, error)
+}
+
+type outputChecker struct {
+	decoder                  logsDecoder
+	checkBalanceConservation bool
+}
+
+// NewOutputChecker creates a component able to verify the invariants of the vm outputs produced by the built-in
+// functions or by the VMs. Only the calls done by the system account are allowed to write under protected keys.
+func NewOutputChecker(args ArgsNewOutputChecker) (*outputChecker, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	decoder, err := logevents.NewLogsDecoder(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outputChecker{
+		decoder:                  decoder,
+		checkBalanceConservation: args.CheckBalanceConservation,
+	}, nil
+}
+
+// Check returns all the invariants broken by the output of the provided input. The output accounts are checked in
+// address order, so the result is deterministic.
+func (oc *outputChecker) Check(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) ([]*Violation, error) {
+	if input == nil {
+		return nil, ErrNilVMInput
+	}
+	if vmOutput == nil {
+		return nil, ErrNilVMOutput
+	}
+
+	violations := make([]*Violation, 0)
+	violations = append(violations, checkGasRemaining(input, vmOutput)...)
+	violations = append(violations, checkTransferIndexes(vmOutput)...)
+	violations = append(violations, oc.checkBalances(vmOutput)...)
+	violations = append(violations, oc.checkProtectedKeys(input, vmOutput)...)
+	violations = append(violations, oc.checkLogs(vmOutput)...)
+
+	return violations, nil
+}
+
+// Verify returns an error describing all the invariants broken by the output of the provided input, or nil if
+// the output is valid
+func (oc *outputChecker) Verify(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error {
+	violations, err := oc.Check(input, vmOutput)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.String())
+	}
+
+	return fmt.Errorf("%w: %s", ErrInvariantsViolated, strings.Join(descriptions, "; "))
+}
+
+func checkGasRemaining(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) []*Violation {
+	if vmOutput.GasRemaining <= input.GasProvided {
+		return nil
+	}
+
+	return []*Violation{{
+		Type:    GasRemainingExceedsGasProvided,
+		Message: fmt.Sprintf("gas remaining %d, gas provided %d", vmOutput.GasRemaining, input.GasProvided),
+	}}
+}
+
+func checkTransferIndexes(vmOutput *vmcommon.VMOutput) []*Violation {
+	violations := make([]*Violation, 0)
+	usedIndexes := make(map[uint32][]byte)
+	for _, outAcc := range sortedOutputAccounts(vmOutput) {
+		for _, transfer := range outAcc.OutputTransfers {
+			if transfer.Index == 0 {
+				violations = append(violations, &Violation{
+					Type:    ZeroTransferIndex,
+					Address: outAcc.Address,
+					Message: "output transfer without index",
+				})
+				continue
+			}
+
+			previousAddress, found := usedIndexes[transfer.Index]
+			if found {
+				violations = append(violations, &Violation{
+					Type:    DuplicateTransferIndex,
+					Address: outAcc.Address,
+					Message: fmt.Sprintf("index %d already used by %x", transfer.Index, previousAddress),
+				})
+				continue
+			}
+
+			usedIndexes[transfer.Index] = outAcc.Address
+		}
+	}
+
+	return violations
+}
+
+// checkBalances verifies that no balance or transferred value is negative and, if enabled, that the REWA is only
+// moved between the output accounts, the balance deltas summing up to zero
+func (oc *outputChecker) checkBalances(vmOutput *vmcommon.VMOutput) []*Violation {
+	violations := make([]*Violation, 0)
+	sumOfDeltas := big.NewInt(0)
+	for _, outAcc := range sortedOutputAccounts(vmOutput) {
+		if outAcc.Balance != nil && outAcc.Balance.Sign() < 0 {
+			violations = append(violations, &Violation{
+				Type:    NegativeBalance,
+				Address: outAcc.Address,
+				Message: fmt.Sprintf("balance %s", outAcc.Balance.String()),
+			})
+		}
+		if outAcc.BalanceDelta != nil {
+			sumOfDeltas.Add(sumOfDeltas, outAcc.BalanceDelta)
+		}
+
+		for _, transfer := range outAcc.OutputTransfers {
+			if transfer.Value != nil && transfer.Value.Sign() < 0 {
+				violations = append(violations, &Violation{
+					Type:    NegativeTransferValue,
+					Address: outAcc.Address,
+					Message: fmt.Sprintf("transfer %d value %s", transfer.Index, transfer.Value.String()),
+				})
+			}
+		}
+	}
+
+	if oc.checkBalanceConservation && sumOfDeltas.Sign() != 0 {
+		violations = append(violations, &Violation{
+			Type:    BalanceNotConserved,
+			Message: fmt.Sprintf("balance deltas sum up to %s", sumOfDeltas.String()),
+		})
+	}
+
+	return violations
+}
+
+func (oc *outputChecker) checkProtectedKeys(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) []*Violation {
+	// the built-in functions write the protected keys directly in the accounts, never through the storage updates,
+	// so the exemption is based only on the caller, whatever function is called
+	if vmcommon.IsSystemAccountAddress(input.CallerAddr) {
+		return nil
+	}
+
+	violations := make([]*Violation, 0)
+	for _, outAcc := range sortedOutputAccounts(vmOutput) {
+		keys := make([]string, 0, len(outAcc.StorageUpdates))
+		for key := range outAcc.StorageUpdates {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			storageUpdate := outAcc.StorageUpdates[key]
+			if storageUpdate == nil || vmcommon.IsAllowedToSaveUnderKey(storageUpdate.Offset) {
+				continue
+			}
+
+			violations = append(violations, &Violation{
+				Type:    ProtectedKeyWrite,
+				Address: outAcc.Address,
+				Message: fmt.Sprintf("key %x written by %s", storageUpdate.Offset, input.Function),
+			})
+		}
+	}
+
+	return violations
+}
+
+func (oc *outputChecker) checkLogs(vmOutput *vmcommon.VMOutput) []*Violation {
+	violations := make([]*Violation, 0)
+	for i, entry := range vmOutput.Logs {
+		if entry == nil {
+			continue
+		}
+
+		_, err := oc.decoder.Decode(entry)
+		if err == nil || errors.Is(err, logevents.ErrUnknownIdentifier) {
+			continue
+		}
+
+		violations = append(violations, &Violation{
+			Type:    MalformedLogEntry,
+			Address: entry.Address,
+			Message: fmt.Sprintf("log %d: %s", i, err.Error()),
+		})
+	}
+
+	return violations
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address, outAcc := range vmOutput.OutputAccounts {
+		if outAcc != nil {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(addresses))
+	for _, address := range addresses {
+		outputAccounts = append(outputAccounts, vmOutput.OutputAccounts[address])
+	}
+
+	return outputAccounts
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (oc *outputChecker) IsInterfaceNil() bool {
+	return oc == nil
+}
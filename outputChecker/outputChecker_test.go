@@ -0,0 +1,235 @@
+package outputChecker
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	caller   = []byte("caller-address-of-32-bytes-long!")
+	receiver = []byte("receiver-address-of-32-bytes-lon")
+)
+
+func createMockArgsOutputChecker() ArgsNewOutputChecker {
+	return ArgsNewOutputChecker{
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+}
+
+func createCallInput(function string) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+		},
+		RecipientAddr: receiver,
+		Function:      function,
+	}
+}
+
+func createValidOutput() *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 900,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(caller): {
+				Address:         caller,
+				BalanceDelta:    big.NewInt(-10),
+				OutputTransfers: []vmcommon.OutputTransfer{{Index: 1, Value: big.NewInt(0)}},
+			},
+			string(receiver): {
+				Address:         receiver,
+				BalanceDelta:    big.NewInt(10),
+				StorageUpdates:  map[string]*vmcommon.StorageUpdate{"key": {Offset: []byte("key"), Data: []byte("value"), Written: true}},
+				OutputTransfers: []vmcommon.OutputTransfer{{Index: 2, Value: big.NewInt(10)}},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte(core.BuiltInFunctionDCDTTransfer),
+				Address:    caller,
+				Topics:     [][]byte{[]byte("TKN-abcdef"), {}, big.NewInt(10).Bytes(), receiver},
+			},
+			{
+				Identifier: []byte("custom event"),
+				Address:    receiver,
+			},
+		},
+	}
+}
+
+func requireViolations(t *testing.T, violations []*Violation, expectedTypes ...ViolationType) {
+	types := make([]ViolationType, 0, len(violations))
+	for _, violation := range violations {
+		types = append(types, violation.Type)
+	}
+
+	require.Equal(t, expectedTypes, types)
+}
+
+func TestNewOutputChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutputChecker()
+		args.Marshalizer = nil
+		checker, err := NewOutputChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewOutputChecker(createMockArgsOutputChecker())
+		require.Nil(t, err)
+		require.False(t, checker.IsInterfaceNil())
+	})
+}
+
+func TestOutputChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	checker, _ := NewOutputChecker(createMockArgsOutputChecker())
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := checker.Check(nil, createValidOutput())
+		require.Equal(t, ErrNilVMInput, err)
+
+		_, err = checker.Check(createCallInput("function"), nil)
+		require.Equal(t, ErrNilVMOutput, err)
+	})
+	t.Run("valid output should not report violations", func(t *testing.T) {
+		t.Parallel()
+
+		violations, err := checker.Check(createCallInput("function"), createValidOutput())
+		require.Nil(t, err)
+		require.Empty(t, violations)
+	})
+	t.Run("gas remaining exceeding gas provided", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := createValidOutput()
+		vmOutput.GasRemaining = 1001
+
+		violations, _ := checker.Check(createCallInput("function"), vmOutput)
+		requireViolations(t, violations, GasRemainingExceedsGasProvided)
+	})
+	t.Run("zero and duplicate transfer indexes", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := createValidOutput()
+		vmOutput.OutputAccounts[string(caller)].OutputTransfers = []vmcommon.OutputTransfer{{Index: 0}, {Index: 2}}
+
+		violations, _ := checker.Check(createCallInput("function"), vmOutput)
+		requireViolations(t, violations, ZeroTransferIndex, DuplicateTransferIndex)
+		require.Equal(t, receiver, violations[1].Address)
+	})
+	t.Run("negative balances and values", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := createValidOutput()
+		vmOutput.OutputAccounts[string(caller)].Balance = big.NewInt(-1)
+		vmOutput.OutputAccounts[string(receiver)].OutputTransfers[0].Value = big.NewInt(-10)
+
+		violations, _ := checker.Check(createCallInput("function"), vmOutput)
+		requireViolations(t, violations, NegativeBalance, NegativeTransferValue)
+	})
+	t.Run("balance not conserved", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := createValidOutput()
+		vmOutput.OutputAccounts[string(receiver)].BalanceDelta = big.NewInt(11)
+
+		violations, _ := checker.Check(createCallInput("function"), vmOutput)
+		require.Empty(t, violations)
+
+		args := createMockArgsOutputChecker()
+		args.CheckBalanceConservation = true
+		conservationChecker, _ := NewOutputChecker(args)
+		violations, _ = conservationChecker.Check(createCallInput("function"), vmOutput)
+		requireViolations(t, violations, BalanceNotConserved)
+		require.Contains(t, violations[0].String(), "balance deltas sum up to 1")
+	})
+	t.Run("protected key write", func(t *testing.T) {
+		t.Parallel()
+
+		protectedKey := []byte(core.ProtectedKeyPrefix + core.DCDTKeyIdentifier + "TKN-abcdef")
+		vmOutput := createValidOutput()
+		vmOutput.OutputAccounts[string(receiver)].StorageUpdates[string(protectedKey)] = &vmcommon.StorageUpdate{
+			Offset: protectedKey,
+			Data:   []byte("value"),
+		}
+
+		violations, _ := checker.Check(createCallInput("function"), vmOutput)
+		requireViolations(t, violations, ProtectedKeyWrite)
+		require.Equal(t, receiver, violations[0].Address)
+
+		systemInput := createCallInput("function")
+		systemInput.CallerAddr = vmcommon.SystemAccountAddress
+		violations, _ = checker.Check(systemInput, vmOutput)
+		require.Empty(t, violations)
+	})
+	t.Run("protected key write of a built-in function called by a user", func(t *testing.T) {
+		t.Parallel()
+
+		protectedKey := []byte(core.ProtectedKeyPrefix + core.DCDTKeyIdentifier + "TKN-abcdef")
+		vmOutput := createValidOutput()
+		vmOutput.OutputAccounts[string(caller)].StorageUpdates = map[string]*vmcommon.StorageUpdate{
+			string(protectedKey): {Offset: protectedKey, Data: []byte("value"), Written: true},
+		}
+
+		violations, _ := checker.Check(createCallInput(core.BuiltInFunctionSaveKeyValue), vmOutput)
+		requireViolations(t, violations, ProtectedKeyWrite)
+		require.Equal(t, caller, violations[0].Address)
+
+		violations, _ = checker.Check(createCallInput(core.BuiltInFunctionDCDTTransfer), vmOutput)
+		requireViolations(t, violations, ProtectedKeyWrite)
+	})
+	t.Run("malformed dcdt log entry", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := createValidOutput()
+		vmOutput.Logs = append(vmOutput.Logs, &vmcommon.LogEntry{
+			Identifier: []byte(core.BuiltInFunctionDCDTLocalBurn),
+			Address:    receiver,
+			Topics:     [][]byte{[]byte("TKN-abcdef")},
+		})
+
+		violations, _ := checker.Check(createCallInput("function"), vmOutput)
+		requireViolations(t, violations, MalformedLogEntry)
+		require.Equal(t, receiver, violations[0].Address)
+	})
+}
+
+func TestOutputChecker_Verify(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOutputChecker()
+	args.CheckBalanceConservation = true
+	checker, _ := NewOutputChecker(args)
+
+	err := checker.Verify(nil, createValidOutput())
+	require.Equal(t, ErrNilVMInput, err)
+
+	err = checker.Verify(createCallInput("function"), createValidOutput())
+	require.Nil(t, err)
+
+	vmOutput := createValidOutput()
+	vmOutput.GasRemaining = 1001
+	vmOutput.OutputAccounts[string(receiver)].BalanceDelta = big.NewInt(11)
+	err = checker.Verify(createCallInput("function"), vmOutput)
+	require.True(t, errors.Is(err, ErrInvariantsViolated))
+	require.Contains(t, err.Error(), string(GasRemainingExceedsGasProvided))
+	require.Contains(t, err.Error(), string(BalanceNotConserved))
+}
@@ -0,0 +1,45 @@
+package outputChecker
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// ViolationType identifies the invariant broken by a vm output
+type ViolationType string
+
+const (
+	// ZeroTransferIndex signals an output transfer which was not indexed
+	ZeroTransferIndex ViolationType = "zero transfer index"
+	// DuplicateTransferIndex signals an index used by more than one output transfer
+	DuplicateTransferIndex ViolationType = "duplicate transfer index"
+	// ProtectedKeyWrite signals a storage update under a protected key, done by a non-system call
+	ProtectedKeyWrite ViolationType = "protected key write"
+	// NegativeBalance signals an output account with a negative balance
+	NegativeBalance ViolationType = "negative balance"
+	// NegativeTransferValue signals an output transfer with a negative value
+	NegativeTransferValue ViolationType = "negative transfer value"
+	// GasRemainingExceedsGasProvided signals an output holding more gas than the execution was provided with
+	GasRemainingExceedsGasProvided ViolationType = "gas remaining exceeds gas provided"
+	// BalanceNotConserved signals that the balance deltas of the output accounts do not sum up to zero
+	BalanceNotConserved ViolationType = "balance not conserved"
+	// MalformedLogEntry signals a DCDT log entry which can not be decoded
+	MalformedLogEntry ViolationType = "malformed log entry"
+)
+
+// Violation describes an invariant broken by a vm output. Address is the output account or the log entry address
+// the violation refers to, if any.
+type Violation struct {
+	Type    ViolationType
+	Address []byte
+	Message string
+}
+
+// String returns the violation description
+func (v *Violation) String() string {
+	if len(v.Address) == 0 {
+		return fmt.Sprintf("%s: %s", v.Type, v.Message)
+	}
+
+	return fmt.Sprintf("%s for %s: %s", v.Type, hex.EncodeToString(v.Address), v.Message)
+}
@@ -1,6 +1,7 @@
 package vmcommon
 
 import (
+	"math"
 	"math/big"
 	"testing"
 
@@ -87,3 +88,144 @@ func TestOutputContext_MergeCompleteAccounts(t *testing.T) {
 	left.MergeOutputAccounts(right)
 	require.Equal(t, expected, left)
 }
+
+type transferIndexProviderStub struct {
+	crtIndex uint32
+}
+
+func (stub *transferIndexProviderStub) NextOutputTransferIndex() uint32 {
+	index := stub.crtIndex
+	stub.crtIndex++
+	return index
+}
+
+func (stub *transferIndexProviderStub) GetCrtTransferIndex() uint32 {
+	return stub.crtIndex
+}
+
+func (stub *transferIndexProviderStub) SetCrtTransferIndex(index uint32) {
+	stub.crtIndex = index
+}
+
+func (stub *transferIndexProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestVMOutput_Merge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil other output should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := &VMOutput{}
+		err := vmOutput.Merge(nil, &transferIndexProviderStub{crtIndex: 1})
+		require.Equal(t, ErrNilVMOutput, err)
+	})
+	t.Run("nil index provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := &VMOutput{}
+		err := vmOutput.Merge(&VMOutput{}, nil)
+		require.Equal(t, ErrNilTransferIndexer, err)
+	})
+	t.Run("unindexed transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := &VMOutput{ReturnData: [][]byte{[]byte("first")}}
+		other := &VMOutput{
+			OutputAccounts: map[string]*OutputAccount{
+				"addr": {Address: []byte("addr"), OutputTransfers: []OutputTransfer{{Index: 0}}},
+			},
+		}
+		err := vmOutput.Merge(other, &transferIndexProviderStub{crtIndex: 1})
+		require.Equal(t, ErrTransfersNotIndexed, err)
+		require.Equal(t, [][]byte{[]byte("first")}, vmOutput.ReturnData)
+	})
+	t.Run("gas used overflow should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := &VMOutput{
+			OutputAccounts: map[string]*OutputAccount{"addr": {Address: []byte("addr"), GasUsed: math.MaxUint64}},
+		}
+		other := &VMOutput{
+			OutputAccounts: map[string]*OutputAccount{"addr": {Address: []byte("addr"), GasUsed: 1}},
+		}
+		err := vmOutput.Merge(other, &transferIndexProviderStub{crtIndex: 1})
+		require.NotNil(t, err)
+		require.Equal(t, uint64(math.MaxUint64), vmOutput.OutputAccounts["addr"].GasUsed)
+	})
+	t.Run("should merge chained outputs", func(t *testing.T) {
+		t.Parallel()
+
+		builtInOutput := &VMOutput{
+			ReturnData:    [][]byte{[]byte("builtin")},
+			ReturnCode:    Ok,
+			ReturnMessage: "",
+			GasRemaining:  1000,
+			GasRefund:     big.NewInt(5),
+			OutputAccounts: map[string]*OutputAccount{
+				"sender": {
+					Address:         []byte("sender"),
+					Nonce:           1,
+					BalanceDelta:    big.NewInt(-10),
+					StorageUpdates:  map[string]*StorageUpdate{"k1": {Offset: []byte("k1"), Data: []byte("v1"), Written: true}},
+					OutputTransfers: []OutputTransfer{{Index: 1, Data: []byte("transfer1")}},
+					GasUsed:         100,
+				},
+			},
+			DeletedAccounts: [][]byte{[]byte("deleted")},
+			TouchedAccounts: [][]byte{[]byte("sender"), []byte("sc")},
+			Logs:            []*LogEntry{{Identifier: []byte("builtin")}},
+		}
+		scCallOutput := &VMOutput{
+			ReturnData:    [][]byte{[]byte("sc")},
+			ReturnCode:    UserError,
+			ReturnMessage: "sc error",
+			GasRemaining:  400,
+			OutputAccounts: map[string]*OutputAccount{
+				"sender": {
+					Address:             []byte("sender"),
+					Nonce:               2,
+					BalanceDelta:        big.NewInt(3),
+					StorageUpdates:      map[string]*StorageUpdate{"k2": {Offset: []byte("k2"), Data: []byte("v2"), Written: true}},
+					OutputTransfers:     []OutputTransfer{{Index: 1, Data: []byte("transfer2")}},
+					GasUsed:             200,
+					BytesAddedToStorage: 4,
+				},
+				"sc": {
+					Address:         []byte("sc"),
+					OutputTransfers: []OutputTransfer{{Index: 2, Data: []byte("transfer3")}},
+				},
+			},
+			DeletedAccounts: [][]byte{[]byte("deleted")},
+			TouchedAccounts: [][]byte{[]byte("sc"), []byte("other")},
+			Logs:            []*LogEntry{{Identifier: []byte("sc")}},
+		}
+		indexProvider := &transferIndexProviderStub{crtIndex: builtInOutput.GetNextAvailableOutputTransferIndex()}
+
+		err := builtInOutput.Merge(scCallOutput, indexProvider)
+		require.Nil(t, err)
+
+		require.Equal(t, [][]byte{[]byte("builtin"), []byte("sc")}, builtInOutput.ReturnData)
+		require.Equal(t, UserError, builtInOutput.ReturnCode)
+		require.Equal(t, "sc error", builtInOutput.ReturnMessage)
+		require.Equal(t, uint64(400), builtInOutput.GasRemaining)
+		require.Equal(t, big.NewInt(5), builtInOutput.GasRefund)
+		require.Equal(t, [][]byte{[]byte("deleted")}, builtInOutput.DeletedAccounts)
+		require.Equal(t, [][]byte{[]byte("sender"), []byte("sc"), []byte("other")}, builtInOutput.TouchedAccounts)
+		require.Equal(t, []*LogEntry{{Identifier: []byte("builtin")}, {Identifier: []byte("sc")}}, builtInOutput.Logs)
+		require.Equal(t, uint32(4), indexProvider.GetCrtTransferIndex())
+
+		sender := builtInOutput.OutputAccounts["sender"]
+		require.Equal(t, uint64(2), sender.Nonce)
+		require.Equal(t, big.NewInt(-7), sender.BalanceDelta)
+		require.Equal(t, uint64(300), sender.GasUsed)
+		require.Equal(t, uint64(4), sender.BytesAddedToStorage)
+		require.Equal(t, 2, len(sender.StorageUpdates))
+		require.Equal(t, []OutputTransfer{
+			{Index: 1, Data: []byte("transfer1")},
+			{Index: 2, Data: []byte("transfer2")},
+		}, sender.OutputTransfers)
+		require.Equal(t, []OutputTransfer{{Index: 3, Data: []byte("transfer3")}}, builtInOutput.OutputAccounts["sc"].OutputTransfers)
+	})
+}
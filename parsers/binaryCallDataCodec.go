@@ -0,0 +1,87 @@
+package parsers
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+var _ CallDataCodec = (*binaryCallDataCodec)(nil)
+
+// binaryCallDataCodec is the compact wire format for large payloads: every field is written as is, after its
+// length encoded as a big endian uint32
+type binaryCallDataCodec struct {
+}
+
+// NewBinaryCallDataCodec creates the codec of the length-prefixed binary data strings
+func NewBinaryCallDataCodec() *binaryCallDataCodec {
+	return &binaryCallDataCodec{}
+}
+
+// SplitFields reads the length-prefixed fields of the data
+func (codec *binaryCallDataCodec) SplitFields(data string) ([]string, error) {
+	fields := make([]string, 0)
+	for offset := 0; offset < len(data); {
+		if len(data)-offset < lengthPrefixSize {
+			return nil, newParseError(codec, fields, len(fields), ReasonTruncatedLengthPrefix, ErrTokenizeFailed)
+		}
+
+		fieldLength := binary.BigEndian.Uint32([]byte(data[offset : offset+lengthPrefixSize]))
+		offset += lengthPrefixSize
+		if uint64(fieldLength) > uint64(len(data)-offset) {
+			return nil, newParseError(codec, fields, len(fields), ReasonTruncatedField, ErrTokenizeFailed)
+		}
+
+		fields = append(fields, data[offset:offset+int(fieldLength)])
+		offset += int(fieldLength)
+	}
+
+	return fields, nil
+}
+
+// ValidateFields does nothing, as the length-prefixed fields leave no room for malformed separators or encodings
+func (codec *binaryCallDataCodec) ValidateFields(_ []string, _ int) error {
+	return nil
+}
+
+// DecodeField returns the field found at the provided index, as it is not encoded
+func (codec *binaryCallDataCodec) DecodeField(fields []string, index int) ([]byte, error) {
+	return []byte(fields[index]), nil
+}
+
+// EncodeField returns the field as it is
+func (codec *binaryCallDataCodec) EncodeField(field []byte) string {
+	return string(field)
+}
+
+// JoinFields writes every field after its length prefix
+func (codec *binaryCallDataCodec) JoinFields(fields []string) string {
+	builder := strings.Builder{}
+	lengthPrefix := make([]byte, lengthPrefixSize)
+	for _, field := range fields {
+		binary.BigEndian.PutUint32(lengthPrefix, uint32(len(field)))
+		builder.Write(lengthPrefix)
+		builder.WriteString(field)
+	}
+
+	return builder.String()
+}
+
+// FieldOffset returns the position in the data of the length prefix of the field found at the provided index
+func (codec *binaryCallDataCodec) FieldOffset(fields []string, index int) int {
+	offset := 0
+	for i := 0; i < index && i < len(fields); i++ {
+		offset += len(fields[i]) + lengthPrefixSize
+	}
+
+	return offset
+}
+
+// IsStrict returns false as the binary codec has no loose formatting to reject
+func (codec *binaryCallDataCodec) IsStrict() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (codec *binaryCallDataCodec) IsInterfaceNil() bool {
+	return codec == nil
+}
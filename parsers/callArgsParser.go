@@ -1,13 +1,37 @@
 package parsers
 
-import "strings"
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/abi"
+)
 
 type callArgsParser struct {
+	codec CallDataCodec
 }
 
 // NewCallArgsParser creates a new parser
 func NewCallArgsParser() *callArgsParser {
-	return &callArgsParser{}
+	return &callArgsParser{
+		codec: NewHexCallDataCodec(),
+	}
+}
+
+// NewStrictCallArgsParser creates a new parser which also rejects uppercase hex, empty arguments and trailing separators
+func NewStrictCallArgsParser() *callArgsParser {
+	return &callArgsParser{
+		codec: NewStrictHexCallDataCodec(),
+	}
+}
+
+// NewCallArgsParserWithCodec creates a new parser of the data strings written in the wire format of the provided codec
+func NewCallArgsParserWithCodec(codec CallDataCodec) (*callArgsParser, error) {
+	if check.IfNil(codec) {
+		return nil, ErrNilCallDataCodec
+	}
+
+	return &callArgsParser{
+		codec: codec,
+	}, nil
 }
 
 // ParseData parses strings of the following format:
@@ -16,7 +40,7 @@ func (parser *callArgsParser) ParseData(data string) (string, [][]byte, error) {
 	var function string
 	var arguments [][]byte
 
-	tokens, err := tokenize(data)
+	tokens, err := splitFields(parser.codec, data, ReasonEmptyFunction)
 	if err != nil {
 		return "", nil, err
 	}
@@ -37,7 +61,14 @@ func (parser *callArgsParser) ParseData(data string) (string, [][]byte, error) {
 // ParseArguments parses strings of the following format:
 // argFoo@hex(argBarHex)...
 func (parser *callArgsParser) ParseArguments(data string) ([][]byte, error) {
-	tokens := strings.Split(data, atSeparator)
+	tokens, err := parser.codec.SplitFields(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return make([][]byte, 0), nil
+	}
+
 	arguments := make([][]byte, 0, len(tokens))
 	arguments = append(arguments, []byte(tokens[0]))
 	parsedArgs, err := parser.parseArguments(tokens)
@@ -49,9 +80,25 @@ func (parser *callArgsParser) ParseArguments(data string) ([][]byte, error) {
 	return arguments, nil
 }
 
+// ParseTypedData parses strings of the following format, decoding each argument by the type at the same index:
+// functionRaw@argFooHex@argBarHex...
+func (parser *callArgsParser) ParseTypedData(data string, types []*abi.TypeDescriptor) (string, []interface{}, error) {
+	function, arguments, err := parser.ParseData(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	values, err := abi.DecodeArguments(arguments, types)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return function, values, nil
+}
+
 func (parser *callArgsParser) parseFunction(tokens []string) (string, error) {
 	if len(tokens) < minNumCallArguments {
-		return "", ErrNilFunction
+		return "", newParseError(parser.codec, tokens, indexOfFunction, ReasonEmptyFunction, ErrNilFunction)
 	}
 
 	function := tokens[indexOfFunction]
@@ -59,10 +106,15 @@ func (parser *callArgsParser) parseFunction(tokens []string) (string, error) {
 }
 
 func (parser *callArgsParser) parseArguments(tokens []string) ([][]byte, error) {
+	err := parser.codec.ValidateFields(tokens, minNumCallArguments)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := make([][]byte, 0)
 
 	for i := minNumCallArguments; i < len(tokens); i++ {
-		argument, err := decodeToken(tokens[i])
+		argument, err := parser.codec.DecodeField(tokens, i)
 		if err != nil {
 			return nil, err
 		}
@@ -1,8 +1,10 @@
 package parsers
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/TerraDharitri/drt-go-chain-vm-common/abi"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,17 +32,17 @@ func TestCallArgsParser_ParseDataWhenErrorneousInput(t *testing.T) {
 	require.NotNil(t, parser)
 
 	function, arguments, err := parser.ParseData("")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyFunction)
 	require.Equal(t, "", function)
 	require.Nil(t, arguments)
 
 	function, arguments, err = parser.ParseData("@a")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyFunction)
 	require.Equal(t, "", function)
 	require.Nil(t, arguments)
 
 	function, arguments, err = parser.ParseData("foo@BADARG")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 1, ReasonInvalidHexCharacter)
 	require.Equal(t, "", function)
 	require.Nil(t, arguments)
 }
@@ -71,6 +73,27 @@ func TestCallArgsParser_ParseArgsWhenErrorneousInput(t *testing.T) {
 	require.NotNil(t, parser)
 
 	arguments, err := parser.ParseArguments("foo@BADARG")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 1, ReasonInvalidHexCharacter)
 	require.Nil(t, arguments)
 }
+
+func TestCallArgsParser_ParseTypedData(t *testing.T) {
+	t.Parallel()
+
+	parser := NewCallArgsParser()
+
+	types := []*abi.TypeDescriptor{abi.U32(), abi.TokenIdentifier(), abi.Bool()}
+	function, values, err := parser.ParseTypedData("fooBar@0100@544b4e2d616263646566@01", types)
+	require.Nil(t, err)
+	require.Equal(t, "fooBar", function)
+	require.Equal(t, []interface{}{uint32(256), []byte("TKN-abcdef"), true}, values)
+
+	_, _, err = parser.ParseTypedData("fooBar@0100", types)
+	require.True(t, errors.Is(err, abi.ErrNumArgumentsMismatch))
+
+	_, _, err = parser.ParseTypedData("fooBar@0100000000@@", types)
+	require.True(t, errors.Is(err, abi.ErrInputTooLong))
+
+	_, _, err = parser.ParseTypedData("@0100", types)
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyFunction)
+}
@@ -0,0 +1,142 @@
+package parsers
+
+import (
+	"testing"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParsersWithCodec_NilCodecShouldErr(t *testing.T) {
+	t.Parallel()
+
+	callArgsParser, err := NewCallArgsParserWithCodec(nil)
+	assert.Nil(t, callArgsParser)
+	assert.Equal(t, ErrNilCallDataCodec, err)
+
+	deployArgsParser, err := NewDeployArgsParserWithCodec(nil)
+	assert.Nil(t, deployArgsParser)
+	assert.Equal(t, ErrNilCallDataCodec, err)
+
+	storageUpdatesParser, err := NewStorageUpdatesParserWithCodec(nil)
+	assert.Nil(t, storageUpdatesParser)
+	assert.Equal(t, ErrNilCallDataCodec, err)
+}
+
+func TestHexCallDataCodec_EncodeAndJoin(t *testing.T) {
+	t.Parallel()
+
+	codec := NewHexCallDataCodec()
+	data := codec.JoinFields([]string{"foo", codec.EncodeField([]byte{10, 11}), codec.EncodeField(nil)})
+	assert.Equal(t, "foo@0a0b@", data)
+
+	function, arguments, err := NewCallArgsParser().ParseData(data)
+	require.Nil(t, err)
+	assert.Equal(t, "foo", function)
+	assert.Equal(t, [][]byte{{10, 11}, {}}, arguments)
+}
+
+func TestBinaryCallDataCodec_SplitFields(t *testing.T) {
+	t.Parallel()
+
+	codec := NewBinaryCallDataCodec()
+
+	t.Run("empty data", func(t *testing.T) {
+		t.Parallel()
+
+		fields, err := codec.SplitFields("")
+		require.Nil(t, err)
+		assert.Empty(t, fields)
+	})
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFields := []string{"foo", "", string([]byte{0, '@', 0xff})}
+		data := codec.JoinFields(expectedFields)
+		assert.Equal(t, "\x00\x00\x00\x03foo\x00\x00\x00\x00\x00\x00\x00\x03\x00@\xff", data)
+
+		fields, err := codec.SplitFields(data)
+		require.Nil(t, err)
+		assert.Equal(t, expectedFields, fields)
+	})
+	t.Run("truncated length prefix", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := codec.SplitFields("\x00\x00\x00\x01a\x00\x00")
+		requireParseError(t, err, ErrTokenizeFailed, 1, ReasonTruncatedLengthPrefix)
+		assert.Equal(t, 5, err.(*ParseError).Offset)
+	})
+	t.Run("truncated field", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := codec.SplitFields("\x00\x00\x00\x01a\xff\xff\xff\xffbb")
+		requireParseError(t, err, ErrTokenizeFailed, 1, ReasonTruncatedField)
+		assert.Equal(t, 5, err.(*ParseError).Offset)
+	})
+}
+
+func TestParsers_BinaryCallDataCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := NewBinaryCallDataCodec()
+
+	t.Run("call args parser", func(t *testing.T) {
+		t.Parallel()
+
+		parser, err := NewCallArgsParserWithCodec(codec)
+		require.Nil(t, err)
+
+		largeArgument := make([]byte, 100000)
+		data := codec.JoinFields([]string{"foo", codec.EncodeField([]byte("@")), codec.EncodeField(largeArgument)})
+		function, arguments, err := parser.ParseData(data)
+		require.Nil(t, err)
+		assert.Equal(t, "foo", function)
+		assert.Equal(t, [][]byte{[]byte("@"), largeArgument}, arguments)
+
+		arguments, err = parser.ParseArguments(codec.JoinFields([]string{"foo", "bar"}))
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("foo"), []byte("bar")}, arguments)
+
+		_, _, err = parser.ParseData("")
+		requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyFunction)
+	})
+	t.Run("deploy args parser", func(t *testing.T) {
+		t.Parallel()
+
+		parser, err := NewDeployArgsParserWithCodec(codec)
+		require.Nil(t, err)
+
+		data := codec.JoinFields([]string{"code", "\x05\x00", "\x01\x00", "arg"})
+		parsed, err := parser.ParseData(data)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("code"), parsed.Code)
+		assert.Equal(t, []byte{5, 0}, parsed.VMType)
+		assert.True(t, parsed.CodeMetadata.Upgradeable)
+		assert.Equal(t, [][]byte{[]byte("arg")}, parsed.Arguments)
+
+		_, err = parser.ParseData(codec.JoinFields([]string{"code", "\x05\x00"}))
+		requireParseError(t, err, ErrInvalidDeployArguments, 2, ReasonMissingArgument)
+
+		_, err = parser.ParseData(codec.JoinFields([]string{"code", "", "\x01\x00"}))
+		requireParseError(t, err, ErrInvalidVMType, 1, ReasonEmptyArgument)
+	})
+	t.Run("storage updates parser", func(t *testing.T) {
+		t.Parallel()
+
+		parser, err := NewStorageUpdatesParserWithCodec(codec)
+		require.Nil(t, err)
+
+		storageUpdates := []*vmcommon.StorageUpdate{
+			{Offset: []byte("key1"), Data: []byte("value1")},
+			{Offset: []byte("key2"), Data: []byte{}},
+		}
+		data := parser.CreateDataFromStorageUpdate(storageUpdates)
+		parsed, err := parser.GetStorageUpdates(data)
+		require.Nil(t, err)
+		assert.Equal(t, storageUpdates, parsed)
+
+		_, err = parser.GetStorageUpdates(codec.JoinFields([]string{"key1", "value1", "key2"}))
+		requireParseError(t, err, ErrInvalidDataString, 2, ReasonUnpairedToken)
+	})
+}
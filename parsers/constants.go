@@ -1,7 +1,6 @@
 package parsers
 
 const atSeparator = "@"
-const atSeparatorChar = '@'
 const startIndexOfConstructorArguments = 3
 const minNumDeployArguments = 3
 const minNumCallArguments = 1
@@ -9,3 +8,5 @@ const indexOfCode = 0
 const indexOfVMType = 1
 const indexOfCodeMetadata = 2
 const indexOfFunction = 0
+const uppercaseHexDigits = "ABCDEF"
+const lengthPrefixSize = 4
@@ -2,10 +2,12 @@ package datafield
 
 import (
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
 // ArgsOperationDataFieldParser holds all the components required to create a new instance of data field parser
 type ArgsOperationDataFieldParser struct {
-	AddressLength int
-	Marshalizer   marshal.Marshalizer
+	AddressLength    int
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator vmcommon.Coordinator
 }
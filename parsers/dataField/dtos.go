@@ -12,6 +12,34 @@ type ResponseParseData struct {
 	Receivers        [][]byte
 	ReceiversShardID []uint32
 	IsRelayed        bool
+	// AffectedAddresses field is used to store the accounts whose roles or guardian settings are changed by the operation
+	AffectedAddresses [][]byte
+	// Roles field is used to store the DCDT roles set or unset by the operation
+	Roles []string
+	// GuardianAddress field is used to store the guardian set by the operation
+	GuardianAddress []byte
+	// TokenType field is used to store the token type set by the operation
+	TokenType string
+	// Relayer field is used to store the address that relayed the transaction and paid its fee
+	Relayer []byte
+	// InnerSender field is used to store the sender of the relayed transaction
+	InnerSender []byte
+	// Transfers field is used to store the routing details of every token transferred by the operation
+	Transfers []*TransferData
+}
+
+// TransferData holds the routing details of a single token transfer parsed from the data field
+type TransferData struct {
+	Token           string
+	Nonce           uint64
+	Value           string
+	Receiver        []byte
+	ReceiverShardID uint32
+	// Function and Arguments hold the smart contract call that follows the transfer, if any
+	Function  string
+	Arguments [][]byte
+	// IsCrossShardSCCall is true if the smart contract call is executed in another shard than the sender's one
+	IsCrossShardSCCall bool
 }
 
 func NewResponseParseDataAsRelayed() *ResponseParseData {
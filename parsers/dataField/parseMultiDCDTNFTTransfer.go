@@ -1,20 +1,19 @@
 package datafield
 
 import (
-	"github.com/TerraDharitri/drt-go-chain-core/core"
-	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
-func (odp *operationDataFieldParser) parseMultiDCDTNFTTransfer(args [][]byte, function string, sender, receiver []byte, numOfShards uint32) *ResponseParseData {
+func (odp *operationDataFieldParser) parseMultiDCDTNFTTransfer(args [][]byte, function string, sender, receiver []byte) *ResponseParseData {
 	responseParse, parsedDCDTTransfers, ok := odp.extractDCDTData(args, function, sender, receiver)
 	if !ok {
 		return responseParse
 	}
-	if core.IsSmartContractAddress(parsedDCDTTransfers.RcvAddr) && isASCIIString(parsedDCDTTransfers.CallFunction) {
+	if vmcommon.Address(parsedDCDTTransfers.RcvAddr).IsSmartContract() && isASCIIString(parsedDCDTTransfers.CallFunction) {
 		responseParse.Function = parsedDCDTTransfers.CallFunction
 	}
 
-	receiverShardID := sharding.ComputeShardID(parsedDCDTTransfers.RcvAddr, numOfShards)
+	receiverShardID := odp.computeShardID(parsedDCDTTransfers.RcvAddr)
 	for _, dcdtTransferData := range parsedDCDTTransfers.DCDTTransfers {
 		if !isASCIIString(string(dcdtTransferData.DCDTTokenName)) {
 			return &ResponseParseData{
@@ -31,6 +30,7 @@ func (odp *operationDataFieldParser) parseMultiDCDTNFTTransfer(args [][]byte, fu
 		responseParse.DCDTValues = append(responseParse.DCDTValues, dcdtTransferData.DCDTValue.String())
 		responseParse.Receivers = append(responseParse.Receivers, parsedDCDTTransfers.RcvAddr)
 		responseParse.ReceiversShardID = append(responseParse.ReceiversShardID, receiverShardID)
+		responseParse.Transfers = append(responseParse.Transfers, odp.newTransferData(dcdtTransferData, parsedDCDTTransfers, sender, parsedDCDTTransfers.RcvAddr, responseParse.Function))
 	}
 
 	return responseParse
@@ -1,11 +1,13 @@
 package datafield
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"testing"
 
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,9 +21,10 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("MultiDCDTNFTTransfer@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483@02@4c4b4d4f412d616162393130@0d3d@058184103ad80ffb19f7@4c4b4641524d2d396431656138@1ecf06@0423fc01830d455ee5510c@656e7465724661726d416e644c6f636b5265776172647350726f7879@00000000000000000500656d0acc53561c5d6f6fd7d7e82bf13247014f615483")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 
 		rcv, _ := hex.DecodeString("000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483")
+		callArg, _ := hex.DecodeString("00000000000000000500656d0acc53561c5d6f6fd7d7e82bf13247014f615483")
 		require.Equal(t, &ResponseParseData{
 			Operation:        "MultiDCDTNFTTransfer",
 			Function:         "enterFarmAndLockRewardsProxy",
@@ -29,6 +32,28 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 			Tokens:           []string{"LKMOA-aab910-0d3d", "LKFARM-9d1ea8-1ecf06"},
 			Receivers:        [][]byte{rcv, rcv},
 			ReceiversShardID: []uint32{1, 1},
+			Transfers: []*TransferData{
+				{
+					Token:              "LKMOA-aab910",
+					Nonce:              0x0d3d,
+					Value:              "26000978570569047546359",
+					Receiver:           rcv,
+					ReceiverShardID:    1,
+					Function:           "enterFarmAndLockRewardsProxy",
+					Arguments:          [][]byte{callArg},
+					IsCrossShardSCCall: true,
+				},
+				{
+					Token:              "LKFARM-9d1ea8",
+					Nonce:              0x1ecf06,
+					Value:              "5005634793810936671326476",
+					Receiver:           rcv,
+					ReceiverShardID:    1,
+					Function:           "enterFarmAndLockRewardsProxy",
+					Arguments:          [][]byte{callArg},
+					IsCrossShardSCCall: true,
+				},
+			},
 		}, res)
 	})
 
@@ -36,7 +61,7 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("MultiDCDTNFTTransfer@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483@02@4d4949552d61626364@00@01@4d4949552d616263646566@02@05")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		rcv, _ := hex.DecodeString("000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483")
 		require.Equal(t, &ResponseParseData{
 			Operation:        "MultiDCDTNFTTransfer",
@@ -44,6 +69,10 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 			Tokens:           []string{"MIIU-abcd", "MIIU-abcdef-02"},
 			Receivers:        [][]byte{rcv, rcv},
 			ReceiversShardID: []uint32{1, 1},
+			Transfers: []*TransferData{
+				{Token: "MIIU-abcd", Value: "1", Receiver: rcv, ReceiverShardID: 1},
+				{Token: "MIIU-abcdef", Nonce: 2, Value: "5", Receiver: rcv, ReceiverShardID: 1},
+			},
 		}, res)
 	})
 
@@ -51,7 +80,7 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("MultiDCDTNFTTransfer@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483@02@4d4949552d61626364@00@01@4d4949552d616263646566@02@05@1")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: OperationTransfer,
 		}, res)
@@ -60,7 +89,7 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("MultiDCDTNFTTransfer@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483@02@4d4949552d61626364@00@01@4d4949552d616263646566@02")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: "MultiDCDTNFTTransfer",
 		}, res)
@@ -70,7 +99,7 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("MultiDCDTNFTTransfer@@@@@@@")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: "MultiDCDTNFTTransfer",
 		}, res)
@@ -80,7 +109,7 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("MultiDCDTNFTTransfer@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904@02@4d4949552d61626364@00@01@4d4949552d616263646566@02@05")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: "MultiDCDTNFTTransfer",
 		}, res)
@@ -89,7 +118,7 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 	t.Run("MultiDCDTNFTTransferWithREWA", func(t *testing.T) {
 		rewaIdentifierHex := hex.EncodeToString([]byte(vmcommon.REWAIdentifier))
 		dataField := []byte(fmt.Sprintf("MultiDCDTNFTTransfer@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483@02@4d4949552d61626364@00@01@%s@00@05", rewaIdentifierHex))
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		rcv, _ := hex.DecodeString("000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483")
 		require.Equal(t, &ResponseParseData{
 			Operation:        "MultiDCDTNFTTransfer",
@@ -97,6 +126,42 @@ func TestMultiDCDTNFTTransferParse(t *testing.T) {
 			Tokens:           []string{"MIIU-abcd", vmcommon.REWAIdentifier},
 			Receivers:        [][]byte{rcv, rcv},
 			ReceiversShardID: []uint32{1, 1},
+			Transfers: []*TransferData{
+				{Token: "MIIU-abcd", Value: "1", Receiver: rcv, ReceiverShardID: 1},
+				{Token: vmcommon.REWAIdentifier, Value: "5", Receiver: rcv, ReceiverShardID: 1},
+			},
 		}, res)
 	})
+
+	t.Run("MultiNFTTransferShardsFromCoordinator", func(t *testing.T) {
+		t.Parallel()
+
+		rcv, _ := hex.DecodeString("000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483")
+		shardCoordinator := mock.NewMultiShardsCoordinatorMock(5)
+		shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+			if bytes.Equal(address, rcv) {
+				return 4
+			}
+			return 3
+		}
+		arguments := createMockArgumentsOperationParser()
+		arguments.ShardCoordinator = shardCoordinator
+		coordinatorParser, _ := NewOperationDataFieldParser(arguments)
+
+		dataField := []byte("MultiDCDTNFTTransfer@" + hex.EncodeToString(rcv) + "@01@4d4949552d616263646566@02@05@" + hex.EncodeToString([]byte("callMe")) + "@07")
+		res := coordinatorParser.Parse(dataField, sender, sender)
+		require.Equal(t, []uint32{4}, res.ReceiversShardID)
+		require.Equal(t, []*TransferData{
+			{
+				Token:              "MIIU-abcdef",
+				Nonce:              2,
+				Value:              "5",
+				Receiver:           rcv,
+				ReceiverShardID:    4,
+				Function:           "callMe",
+				Arguments:          [][]byte{{7}},
+				IsCrossShardSCCall: true,
+			},
+		}, res.Transfers)
+	})
 }
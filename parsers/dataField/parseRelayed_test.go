@@ -0,0 +1,176 @@
+package datafield
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+var relayer = bytes.Repeat([]byte{7}, 32)
+
+func createRelayedV2DataField(innerReceiver []byte, innerData []byte) []byte {
+	return []byte(core.RelayedTransactionV2 + "@" + hex.EncodeToString(innerReceiver) + "@0a@" +
+		hex.EncodeToString(innerData) + "@01a2")
+}
+
+func createRelayedV1DataField(t *testing.T, innerTx *transaction.Transaction) []byte {
+	txBytes, err := json.Marshal(innerTx)
+	require.Nil(t, err)
+
+	return []byte(core.RelayedTransaction + "@" + hex.EncodeToString(txBytes))
+}
+
+func TestOperationDataFieldParser_ParseWithRelayer(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("EmptyRelayerShouldParseAsRegularTransaction", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTTransfer@4d4949552d616263646566@0102")
+		require.Equal(t, parser.Parse(dataField, sender, receiver), parser.ParseWithRelayer(dataField, sender, receiver, nil))
+	})
+
+	t.Run("DCDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTTransfer@4d4949552d616263646566@0102@" + hex.EncodeToString([]byte("buy")))
+		res := parser.ParseWithRelayer(dataField, sender, receiverSC, relayer)
+		require.Equal(t, &ResponseParseData{
+			Operation:        core.BuiltInFunctionDCDTTransfer,
+			Function:         "buy",
+			Tokens:           []string{"MIIU-abcdef"},
+			DCDTValues:       []string{"258"},
+			Receivers:        [][]byte{receiverSC},
+			ReceiversShardID: []uint32{0},
+			IsRelayed:        true,
+			Relayer:          relayer,
+			InnerSender:      sender,
+			Transfers: []*TransferData{
+				{
+					Token:              "MIIU-abcdef",
+					Value:              "258",
+					Receiver:           receiverSC,
+					ReceiverShardID:    0,
+					Function:           "buy",
+					Arguments:          [][]byte{},
+					IsCrossShardSCCall: true,
+				},
+			},
+		}, res)
+	})
+
+	t.Run("DCDTNFTTransferShouldKeepTheParsedReceiver", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTNFTTransfer@4c4b4641524d2d396431656138@34ae14@728faa2c8883760aaf53bb@" + hex.EncodeToString(receiverSC))
+		res := parser.ParseWithRelayer(dataField, sender, sender, relayer)
+		require.Equal(t, &ResponseParseData{
+			Operation:        core.BuiltInFunctionDCDTNFTTransfer,
+			Tokens:           []string{"LKFARM-9d1ea8-34ae14"},
+			DCDTValues:       []string{"138495980998569893315957691"},
+			Receivers:        [][]byte{receiverSC},
+			ReceiversShardID: []uint32{0},
+			IsRelayed:        true,
+			Relayer:          relayer,
+			InnerSender:      sender,
+			Transfers: []*TransferData{
+				{Token: "LKFARM-9d1ea8", Nonce: 0x34ae14, Value: "138495980998569893315957691", Receiver: receiverSC, ReceiverShardID: 0},
+			},
+		}, res)
+	})
+
+	t.Run("RelayedV2WithRelayer", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := createRelayedV2DataField(receiverSC, []byte("callMe@02"))
+		res := parser.ParseWithRelayer(dataField, sender, receiver, relayer)
+		require.Equal(t, &ResponseParseData{
+			Operation:        OperationTransfer,
+			Function:         "callMe",
+			Receivers:        [][]byte{receiverSC},
+			ReceiversShardID: []uint32{0},
+			IsRelayed:        true,
+			Relayer:          relayer,
+			InnerSender:      receiver,
+		}, res)
+	})
+}
+
+func TestOperationDataFieldParser_ParseNestedRelayed(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("RelayedV1InRelayedV2", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.Transaction{
+			SndAddr: relayer,
+			RcvAddr: receiverSC,
+			Data:    []byte("callMe@02"),
+		}
+		dataField := createRelayedV2DataField(receiver, createRelayedV1DataField(t, innerTx))
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation:        OperationTransfer,
+			Function:         "callMe",
+			Receivers:        [][]byte{receiverSC},
+			ReceiversShardID: []uint32{0},
+			IsRelayed:        true,
+			Relayer:          sender,
+			InnerSender:      relayer,
+		}, res)
+	})
+
+	t.Run("RelayedV1WithRelayerField", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.Transaction{
+			SndAddr:     receiver,
+			RcvAddr:     receiverSC,
+			RelayerAddr: relayer,
+			Data:        []byte("DCDTTransfer@4d4949552d616263646566@0102"),
+		}
+		res := parser.Parse(createRelayedV1DataField(t, innerTx), sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation:        core.BuiltInFunctionDCDTTransfer,
+			Tokens:           []string{"MIIU-abcdef"},
+			DCDTValues:       []string{"258"},
+			Receivers:        [][]byte{receiverSC},
+			ReceiversShardID: []uint32{0},
+			IsRelayed:        true,
+			Relayer:          sender,
+			InnerSender:      receiver,
+			Transfers: []*TransferData{
+				{Token: "MIIU-abcdef", Value: "258", Receiver: receiverSC, ReceiverShardID: 0},
+			},
+		}, res)
+	})
+
+	t.Run("MaxNestingLevel", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("callMe@02")
+		for i := 0; i < maxRelayedNestingLevel; i++ {
+			dataField = createRelayedV2DataField(receiverSC, dataField)
+		}
+		res := parser.Parse(dataField, sender, receiver)
+		require.True(t, res.IsRelayed)
+		require.Equal(t, "callMe", res.Function)
+		require.Equal(t, sender, res.Relayer)
+		require.Equal(t, receiverSC, res.InnerSender)
+
+		dataField = createRelayedV2DataField(receiverSC, dataField)
+		res = parser.Parse(dataField, sender, receiver)
+		require.Equal(t, NewResponseParseDataAsRelayed(), res)
+	})
+}
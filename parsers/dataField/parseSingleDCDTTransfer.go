@@ -1,7 +1,6 @@
 package datafield
 
 import (
-	"github.com/TerraDharitri/drt-go-chain-core/core"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
@@ -11,7 +10,7 @@ func (odp *operationDataFieldParser) parseSingleDCDTTransfer(args [][]byte, func
 		return responseParse
 	}
 
-	if core.IsSmartContractAddress(receiver) && isASCIIString(parsedDCDTTransfers.CallFunction) {
+	if vmcommon.Address(receiver).IsSmartContract() && isASCIIString(parsedDCDTTransfers.CallFunction) {
 		responseParse.Function = parsedDCDTTransfers.CallFunction
 	}
 
@@ -22,6 +21,7 @@ func (odp *operationDataFieldParser) parseSingleDCDTTransfer(args [][]byte, func
 	firstTransfer := parsedDCDTTransfers.DCDTTransfers[0]
 	responseParse.Tokens = append(responseParse.Tokens, string(firstTransfer.DCDTTokenName))
 	responseParse.DCDTValues = append(responseParse.DCDTValues, firstTransfer.DCDTValue.String())
+	responseParse.Transfers = append(responseParse.Transfers, odp.newTransferData(firstTransfer, parsedDCDTTransfers, sender, receiver, responseParse.Function))
 
 	return responseParse
 }
@@ -38,3 +38,28 @@ func (odp *operationDataFieldParser) extractDCDTData(args [][]byte, function str
 
 	return responseParse, parsedDCDTTransfers, true
 }
+
+func (odp *operationDataFieldParser) newTransferData(
+	transfer *vmcommon.DCDTTransfer,
+	parsedDCDTTransfers *vmcommon.ParsedDCDTTransfers,
+	sender []byte,
+	receiver []byte,
+	function string,
+) *TransferData {
+	transferData := &TransferData{
+		Token:           string(transfer.DCDTTokenName),
+		Nonce:           transfer.DCDTTokenNonce,
+		Value:           transfer.DCDTValue.String(),
+		Receiver:        receiver,
+		ReceiverShardID: odp.computeShardID(receiver),
+	}
+	if len(function) == 0 {
+		return transferData
+	}
+
+	transferData.Function = function
+	transferData.Arguments = parsedDCDTTransfers.CallArgs
+	transferData.IsCrossShardSCCall = odp.computeShardID(sender) != transferData.ReceiverShardID
+
+	return transferData
+}
@@ -16,7 +16,7 @@ func TestParseDCDTTransfer(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTTransfer@1234@011")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: OperationTransfer,
 		}, res)
@@ -26,7 +26,7 @@ func TestParseDCDTTransfer(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTTransfer@1234")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTTransfer",
 		}, res)
@@ -36,11 +36,14 @@ func TestParseDCDTTransfer(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTTransfer@544f4b454e@")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTTransfer",
 			Tokens:     []string{"TOKEN"},
 			DCDTValues: []string{"0"},
+			Transfers: []*TransferData{
+				{Token: "TOKEN", Value: "0", Receiver: receiver, ReceiverShardID: 0},
+			},
 		}, res)
 	})
 
@@ -48,18 +51,29 @@ func TestParseDCDTTransfer(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTTransfer@544f4b454e@01@63616c6c4d65")
-		res := parser.Parse(dataField, sender, receiverSC, 3)
+		res := parser.Parse(dataField, sender, receiverSC)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTTransfer",
 			Function:   "callMe",
 			DCDTValues: []string{"1"},
 			Tokens:     []string{"TOKEN"},
+			Transfers: []*TransferData{
+				{
+					Token:              "TOKEN",
+					Value:              "1",
+					Receiver:           receiverSC,
+					ReceiverShardID:    0,
+					Function:           "callMe",
+					Arguments:          [][]byte{},
+					IsCrossShardSCCall: true,
+				},
+			},
 		}, res)
 	})
 
 	t.Run("TransferNonAsciiStringToken", func(t *testing.T) {
 		dataField := []byte("DCDTTransfer@055de6a779bbac0000@01")
-		res := parser.Parse(dataField, sender, receiverSC, 3)
+		res := parser.Parse(dataField, sender, receiverSC)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTTransfer",
 		}, res)
@@ -3,17 +3,16 @@ package datafield
 import (
 	"bytes"
 
-	"github.com/TerraDharitri/drt-go-chain-core/core"
-	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
-func (odp *operationDataFieldParser) parseSingleDCDTNFTTransfer(args [][]byte, function string, sender, receiver []byte, numOfShards uint32) *ResponseParseData {
+func (odp *operationDataFieldParser) parseSingleDCDTNFTTransfer(args [][]byte, function string, sender, receiver []byte) *ResponseParseData {
 	responseParse, parsedDCDTTransfers, ok := odp.extractDCDTData(args, function, sender, receiver)
 	if !ok {
 		return responseParse
 	}
 
-	if core.IsSmartContractAddress(parsedDCDTTransfers.RcvAddr) && isASCIIString(parsedDCDTTransfers.CallFunction) {
+	if vmcommon.Address(parsedDCDTTransfers.RcvAddr).IsSmartContract() && isASCIIString(parsedDCDTTransfers.CallFunction) {
 		responseParse.Function = parsedDCDTTransfers.CallFunction
 	}
 
@@ -27,7 +26,7 @@ func (odp *operationDataFieldParser) parseSingleDCDTNFTTransfer(args [][]byte, f
 	}
 
 	dcdtNFTTransfer := parsedDCDTTransfers.DCDTTransfers[0]
-	receiverShardID := sharding.ComputeShardID(rcvAddr, numOfShards)
+	receiverShardID := odp.computeShardID(rcvAddr)
 	token := computeTokenIdentifier(string(dcdtNFTTransfer.DCDTTokenName), dcdtNFTTransfer.DCDTTokenNonce)
 
 	responseParse.Tokens = append(responseParse.Tokens, token)
@@ -39,6 +38,7 @@ func (odp *operationDataFieldParser) parseSingleDCDTNFTTransfer(args [][]byte, f
 
 	responseParse.Receivers = append(responseParse.Receivers, rcvAddr)
 	responseParse.ReceiversShardID = append(responseParse.ReceiversShardID, receiverShardID)
+	responseParse.Transfers = append(responseParse.Transfers, odp.newTransferData(dcdtNFTTransfer, parsedDCDTTransfers, sender, rcvAddr, responseParse.Function))
 
 	return responseParse
 }
@@ -0,0 +1,157 @@
+package datafield
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+)
+
+const (
+	argsRolesStartPosition       = 1
+	argsAddressesStartPosition   = 1
+	argsNewAddressPosition       = 1
+	argsTokenTypePosition        = 1
+	argsGuardianPosition         = 0
+	numArgsSetTokenType          = 2
+	numArgsNFTCreateRoleTransfer = 2
+)
+
+func parseRolesOperation(args [][]byte, funcName string, receiver []byte) *ResponseParseData {
+	responseData := &ResponseParseData{
+		Operation: funcName,
+	}
+
+	if len(args) <= argsRolesStartPosition {
+		return responseData
+	}
+
+	token := string(args[argsTokenPosition])
+	if !isASCIIString(token) {
+		return responseData
+	}
+
+	roles := make([]string, 0, len(args)-argsRolesStartPosition)
+	for _, role := range args[argsRolesStartPosition:] {
+		if !isASCIIString(string(role)) {
+			return responseData
+		}
+		roles = append(roles, string(role))
+	}
+
+	responseData.Tokens = append(responseData.Tokens, token)
+	responseData.Roles = roles
+	responseData.AffectedAddresses = append(responseData.AffectedAddresses, receiver)
+
+	return responseData
+}
+
+func (odp *operationDataFieldParser) parseNFTCreateRoleTransfer(args [][]byte, funcName string) *ResponseParseData {
+	responseData := &ResponseParseData{
+		Operation: funcName,
+	}
+
+	if len(args) != numArgsNFTCreateRoleTransfer {
+		return responseData
+	}
+
+	token := string(args[argsTokenPosition])
+	if !isASCIIString(token) {
+		return responseData
+	}
+
+	responseData.Tokens = append(responseData.Tokens, token)
+	responseData.Roles = append(responseData.Roles, core.DCDTRoleNFTCreate)
+
+	// the second argument is the nonce, instead of the new address, on the cross shard step of the role transfer
+	newAddress := args[argsNewAddressPosition]
+	if len(newAddress) == odp.addressLength {
+		responseData.AffectedAddresses = append(responseData.AffectedAddresses, newAddress)
+	}
+
+	return responseData
+}
+
+func (odp *operationDataFieldParser) parseTransferRoleAddressesOperation(args [][]byte, funcName string) *ResponseParseData {
+	responseData := &ResponseParseData{
+		Operation: funcName,
+	}
+
+	if len(args) <= argsAddressesStartPosition {
+		return responseData
+	}
+
+	token := string(args[argsTokenPosition])
+	if !isASCIIString(token) {
+		return responseData
+	}
+
+	addresses := make([][]byte, 0, len(args)-argsAddressesStartPosition)
+	for _, address := range args[argsAddressesStartPosition:] {
+		if len(address) != odp.addressLength {
+			return responseData
+		}
+		addresses = append(addresses, address)
+	}
+
+	responseData.Tokens = append(responseData.Tokens, token)
+	responseData.Roles = append(responseData.Roles, core.DCDTRoleTransfer)
+	responseData.AffectedAddresses = addresses
+
+	return responseData
+}
+
+func parseGlobalSettingOperation(args [][]byte, funcName string) *ResponseParseData {
+	responseData := &ResponseParseData{
+		Operation: funcName,
+	}
+
+	if len(args) == 0 {
+		return responseData
+	}
+
+	token := string(args[argsTokenPosition])
+	if !isASCIIString(token) {
+		return responseData
+	}
+
+	responseData.Tokens = append(responseData.Tokens, token)
+
+	return responseData
+}
+
+func parseSetTokenType(args [][]byte, funcName string) *ResponseParseData {
+	responseData := &ResponseParseData{
+		Operation: funcName,
+	}
+
+	if len(args) != numArgsSetTokenType {
+		return responseData
+	}
+
+	token := string(args[argsTokenPosition])
+	tokenType := string(args[argsTokenTypePosition])
+	if !isASCIIString(token) || !isASCIIString(tokenType) {
+		return responseData
+	}
+
+	responseData.Tokens = append(responseData.Tokens, token)
+	responseData.TokenType = tokenType
+
+	return responseData
+}
+
+func (odp *operationDataFieldParser) parseGuardianOperation(args [][]byte, funcName string, sender []byte) *ResponseParseData {
+	responseData := &ResponseParseData{
+		Operation:         funcName,
+		AffectedAddresses: [][]byte{sender},
+	}
+
+	if funcName != core.BuiltInFunctionSetGuardian || len(args) == 0 {
+		return responseData
+	}
+
+	guardian := args[argsGuardianPosition]
+	if len(guardian) == odp.addressLength {
+		responseData.GuardianAddress = guardian
+	}
+
+	return responseData
+}
@@ -0,0 +1,208 @@
+package datafield
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRolesOperation(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("SetDCDTRole", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(core.BuiltInFunctionSetDCDTRole + "@4d4949552d616263646566@" + hex.EncodeToString([]byte(core.DCDTRoleLocalMint)) +
+			"@" + hex.EncodeToString([]byte(core.DCDTRoleLocalBurn)))
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation:         core.BuiltInFunctionSetDCDTRole,
+			Tokens:            []string{"MIIU-abcdef"},
+			Roles:             []string{core.DCDTRoleLocalMint, core.DCDTRoleLocalBurn},
+			AffectedAddresses: [][]byte{receiver},
+		}, res)
+	})
+
+	t.Run("UnSetDCDTRole", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(core.BuiltInFunctionUnSetDCDTRole + "@4d4949552d616263646566@" + hex.EncodeToString([]byte(core.DCDTRoleNFTBurn)))
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation:         core.BuiltInFunctionUnSetDCDTRole,
+			Tokens:            []string{"MIIU-abcdef"},
+			Roles:             []string{core.DCDTRoleNFTBurn},
+			AffectedAddresses: [][]byte{receiver},
+		}, res)
+	})
+
+	t.Run("SetDCDTRoleWithoutRoles", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(core.BuiltInFunctionSetDCDTRole + "@4d4949552d616263646566")
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.BuiltInFunctionSetDCDTRole,
+		}, res)
+	})
+
+	t.Run("SetDCDTRoleNonASCIIRole", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(core.BuiltInFunctionSetDCDTRole + "@4d4949552d616263646566@ff")
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.BuiltInFunctionSetDCDTRole,
+		}, res)
+	})
+}
+
+func TestParseNFTCreateRoleTransfer(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("FromSystemSC", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTNFTCreateRoleTransfer@4d4949552d616263646566@" + hex.EncodeToString(receiver))
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation:         core.BuiltInFunctionDCDTNFTCreateRoleTransfer,
+			Tokens:            []string{"MIIU-abcdef"},
+			Roles:             []string{core.DCDTRoleNFTCreate},
+			AffectedAddresses: [][]byte{receiver},
+		}, res)
+	})
+
+	t.Run("CrossShardStep", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTNFTCreateRoleTransfer@4d4949552d616263646566@0a")
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.BuiltInFunctionDCDTNFTCreateRoleTransfer,
+			Tokens:    []string{"MIIU-abcdef"},
+			Roles:     []string{core.DCDTRoleNFTCreate},
+		}, res)
+	})
+
+	t.Run("InvalidNumberOfArguments", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTNFTCreateRoleTransfer@4d4949552d616263646566")
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.BuiltInFunctionDCDTNFTCreateRoleTransfer,
+		}, res)
+	})
+}
+
+func TestParseTransferRoleAddressesOperation(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("AddAddress", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress + "@4d4949552d616263646566@" +
+			hex.EncodeToString(sender) + "@" + hex.EncodeToString(receiver))
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation:         vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress,
+			Tokens:            []string{"MIIU-abcdef"},
+			Roles:             []string{core.DCDTRoleTransfer},
+			AffectedAddresses: [][]byte{sender, receiver},
+		}, res)
+	})
+
+	t.Run("DeleteAddress", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress + "@4d4949552d616263646566@" + hex.EncodeToString(receiver))
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation:         vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress,
+			Tokens:            []string{"MIIU-abcdef"},
+			Roles:             []string{core.DCDTRoleTransfer},
+			AffectedAddresses: [][]byte{receiver},
+		}, res)
+	})
+
+	t.Run("InvalidAddressLength", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress + "@4d4949552d616263646566@" +
+			hex.EncodeToString(receiver) + "@0102")
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress,
+		}, res)
+	})
+}
+
+func TestParseGlobalSettingOperation(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	functions := []string{
+		core.BuiltInFunctionDCDTPause,
+		core.BuiltInFunctionDCDTUnPause,
+		core.BuiltInFunctionDCDTSetLimitedTransfer,
+		core.BuiltInFunctionDCDTUnSetLimitedTransfer,
+		vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll,
+	}
+	for _, function := range functions {
+		res := parser.Parse([]byte(function+"@4d4949552d616263646566"), sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: function,
+			Tokens:    []string{"MIIU-abcdef"},
+		}, res)
+
+		res = parser.Parse([]byte(function), sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: function,
+		}, res)
+	}
+}
+
+func TestParseSetTokenType(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("ShouldWork", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(core.DCDTSetTokenType + "@4d4949552d616263646566@" + hex.EncodeToString([]byte(core.NonFungibleDCDTv2)))
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.DCDTSetTokenType,
+			Tokens:    []string{"MIIU-abcdef"},
+			TokenType: core.NonFungibleDCDTv2,
+		}, res)
+	})
+
+	t.Run("MissingTokenType", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte(core.DCDTSetTokenType + "@4d4949552d616263646566")
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.DCDTSetTokenType,
+		}, res)
+	})
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
-	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 	"github.com/TerraDharitri/drt-go-chain-vm-common/parsers"
@@ -20,9 +19,11 @@ const (
 
 	minArgumentsQuantityOperationDCDT = 2
 	minArgumentsQuantityOperationNFT  = 3
+	minArgumentsModifyOperationNFT    = 2
 	numArgsRelayedV2                  = 4
 	receiverAddressIndexRelayedV2     = 0
 	dataFieldIndexRelayedV2           = 2
+	maxRelayedNestingLevel            = 3
 
 	argsTokenPosition                   = 0
 	argsNoncePosition                   = 1
@@ -38,6 +39,7 @@ type operationDataFieldParser struct {
 	addressLength      int
 	argsParser         vmcommon.CallArgsParser
 	dcdtTransferParser vmcommon.DCDTTransferParser
+	shardCoordinator   vmcommon.Coordinator
 }
 
 // NewOperationDataFieldParser will return a new instance of operationDataFieldParser
@@ -48,6 +50,9 @@ func NewOperationDataFieldParser(args *ArgsOperationDataFieldParser) (*operation
 	if args.AddressLength == 0 {
 		return nil, errInvalidAddressLength
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, core.ErrNilShardCoordinator
+	}
 
 	argsParser := parsers.NewCallArgsParser()
 	dcdtTransferParser, err := parsers.NewDCDTTransferParser(args.Marshalizer)
@@ -59,16 +64,44 @@ func NewOperationDataFieldParser(args *ArgsOperationDataFieldParser) (*operation
 		argsParser:           argsParser,
 		dcdtTransferParser:   dcdtTransferParser,
 		addressLength:        args.AddressLength,
+		shardCoordinator:     args.ShardCoordinator,
 		builtInFunctionsList: getAllBuiltInFunctions(),
 	}, nil
 }
 
+// computeShardID returns the shard of the address, the shard coordinator being checked when creating the parser
+func (odp *operationDataFieldParser) computeShardID(address vmcommon.Address) uint32 {
+	shardID, _ := address.ShardID(odp.shardCoordinator)
+
+	return shardID
+}
+
 // Parse will parse the provided data field
-func (odp *operationDataFieldParser) Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *ResponseParseData {
-	return odp.parse(dataField, sender, receiver, false, numOfShards)
+func (odp *operationDataFieldParser) Parse(dataField []byte, sender, receiver []byte) *ResponseParseData {
+	return odp.parse(dataField, sender, receiver, nil, 0)
+}
+
+// ParseWithRelayer will parse the provided data field of a transaction that carries the relayer as a field (relayed v3).
+// If the relayer is empty, the data field is parsed as the one of a regular transaction.
+func (odp *operationDataFieldParser) ParseWithRelayer(dataField []byte, sender, receiver, relayer []byte) *ResponseParseData {
+	return odp.parse(dataField, sender, receiver, relayer, 0)
+}
+
+func (odp *operationDataFieldParser) parse(dataField []byte, sender, receiver, relayer []byte, nestingLevel int) *ResponseParseData {
+	if len(relayer) == 0 {
+		return odp.parseOperation(dataField, sender, receiver, nestingLevel)
+	}
+
+	tx := &transaction.Transaction{
+		SndAddr: sender,
+		RcvAddr: receiver,
+		Data:    dataField,
+	}
+
+	return odp.parseInnerTx(tx, relayer, nestingLevel)
 }
 
-func (odp *operationDataFieldParser) parse(dataField []byte, sender, receiver []byte, ignoreRelayed bool, numOfShards uint32) *ResponseParseData {
+func (odp *operationDataFieldParser) parseOperation(dataField []byte, sender, receiver []byte, nestingLevel int) *ResponseParseData {
 	responseParse := &ResponseParseData{
 		Operation: OperationTransfer,
 	}
@@ -88,9 +121,9 @@ func (odp *operationDataFieldParser) parse(dataField []byte, sender, receiver []
 	case core.BuiltInFunctionDCDTTransfer:
 		return odp.parseSingleDCDTTransfer(args, function, sender, receiver)
 	case core.BuiltInFunctionDCDTNFTTransfer:
-		return odp.parseSingleDCDTNFTTransfer(args, function, sender, receiver, numOfShards)
+		return odp.parseSingleDCDTNFTTransfer(args, function, sender, receiver)
 	case core.BuiltInFunctionMultiDCDTNFTTransfer:
-		return odp.parseMultiDCDTNFTTransfer(args, function, sender, receiver, numOfShards)
+		return odp.parseMultiDCDTNFTTransfer(args, function, sender, receiver)
 	case core.BuiltInFunctionDCDTLocalBurn, core.BuiltInFunctionDCDTLocalMint:
 		return parseQuantityOperationDCDT(args, function)
 	case core.BuiltInFunctionDCDTWipe, core.BuiltInFunctionDCDTFreeze, core.BuiltInFunctionDCDTUnFreeze:
@@ -99,11 +132,25 @@ func (odp *operationDataFieldParser) parse(dataField []byte, sender, receiver []
 		return parseQuantityOperationNFT(args, function)
 	case core.DCDTMetaDataRecreate, core.DCDTMetaDataUpdate, core.DCDTSetNewURIs, core.DCDTModifyCreator, core.DCDTModifyRoyalties, core.BuiltInFunctionDCDTNFTAddURI, core.BuiltInFunctionDCDTNFTUpdateAttributes:
 		return parseModifyOperationNFT(args, function)
+	case core.BuiltInFunctionSetDCDTRole, core.BuiltInFunctionUnSetDCDTRole:
+		return parseRolesOperation(args, function, receiver)
+	case core.BuiltInFunctionDCDTNFTCreateRoleTransfer:
+		return odp.parseNFTCreateRoleTransfer(args, function)
+	case vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress, vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress:
+		return odp.parseTransferRoleAddressesOperation(args, function)
+	case core.BuiltInFunctionDCDTPause, core.BuiltInFunctionDCDTUnPause,
+		core.BuiltInFunctionDCDTSetLimitedTransfer, core.BuiltInFunctionDCDTUnSetLimitedTransfer,
+		vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll, vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll:
+		return parseGlobalSettingOperation(args, function)
+	case core.DCDTSetTokenType:
+		return parseSetTokenType(args, function)
+	case core.BuiltInFunctionSetGuardian, core.BuiltInFunctionGuardAccount, core.BuiltInFunctionUnGuardAccount:
+		return odp.parseGuardianOperation(args, function, sender)
 	case core.RelayedTransaction, core.RelayedTransactionV2:
-		if ignoreRelayed {
+		if nestingLevel >= maxRelayedNestingLevel {
 			return NewResponseParseDataAsRelayed()
 		}
-		return odp.parseRelayed(function, args, receiver, numOfShards)
+		return odp.parseRelayed(function, args, sender, receiver, nestingLevel)
 	}
 
 	isBuiltInFunc := isBuiltInFunction(odp.builtInFunctionsList, function)
@@ -111,50 +158,55 @@ func (odp *operationDataFieldParser) parse(dataField []byte, sender, receiver []
 		responseParse.Operation = function
 	}
 
-	if function != "" && core.IsSmartContractAddress(receiver) && isASCIIString(function) {
+	if function != "" && vmcommon.Address(receiver).IsSmartContract() && isASCIIString(function) {
 		responseParse.Function = function
 	}
 
 	return responseParse
 }
 
-func (odp *operationDataFieldParser) parseRelayed(function string, args [][]byte, receiver []byte, numOfShards uint32) *ResponseParseData {
+func (odp *operationDataFieldParser) parseRelayed(function string, args [][]byte, sender, receiver []byte, nestingLevel int) *ResponseParseData {
 	if len(args) == 0 {
-		return &ResponseParseData{
-			IsRelayed: true,
-		}
+		return NewResponseParseDataAsRelayed()
 	}
 
 	tx, ok := extractInnerTx(function, args, receiver)
 	if !ok {
-		return &ResponseParseData{
-			IsRelayed: true,
-		}
+		return NewResponseParseDataAsRelayed()
+	}
+
+	// the sender of the relayed transaction is the relayer of the inner transaction
+	return odp.parseInnerTx(tx, sender, nestingLevel)
+}
+
+// parseInnerTx parses the transaction relayed by the provided relayer. For nested relayed transactions, the inner
+// sender is the sender of the innermost transaction, while the relayer is the outermost one, who pays the fee.
+func (odp *operationDataFieldParser) parseInnerTx(tx *transaction.Transaction, relayer []byte, nestingLevel int) *ResponseParseData {
+	res := odp.parse(tx.Data, tx.SndAddr, tx.RcvAddr, tx.RelayerAddr, nestingLevel+1)
+	if isInvalidRelayed(res) {
+		return NewResponseParseDataAsRelayed()
 	}
 
-	res := odp.parse(tx.Data, tx.SndAddr, tx.RcvAddr, true, numOfShards)
+	response := *res
+	response.IsRelayed = true
+	response.Relayer = relayer
 	if res.IsRelayed {
-		return &ResponseParseData{
-			IsRelayed: true,
-		}
+		return &response
 	}
 
-	receivers := [][]byte{tx.RcvAddr}
-	receiversShardID := []uint32{sharding.ComputeShardID(tx.RcvAddr, numOfShards)}
+	response.InnerSender = tx.SndAddr
+	response.Receivers = [][]byte{tx.RcvAddr}
+	response.ReceiversShardID = []uint32{odp.computeShardID(tx.RcvAddr)}
 	if res.Operation == core.BuiltInFunctionMultiDCDTNFTTransfer || res.Operation == core.BuiltInFunctionDCDTNFTTransfer {
-		receivers = res.Receivers
-		receiversShardID = res.ReceiversShardID
+		response.Receivers = res.Receivers
+		response.ReceiversShardID = res.ReceiversShardID
 	}
 
-	return &ResponseParseData{
-		Operation:        res.Operation,
-		Function:         res.Function,
-		DCDTValues:       res.DCDTValues,
-		Tokens:           res.Tokens,
-		Receivers:        receivers,
-		ReceiversShardID: receiversShardID,
-		IsRelayed:        true,
-	}
+	return &response
+}
+
+func isInvalidRelayed(res *ResponseParseData) bool {
+	return res.IsRelayed && len(res.Operation) == 0
 }
 
 func extractInnerTx(function string, args [][]byte, receiver []byte) (*transaction.Transaction, bool) {
@@ -254,7 +306,7 @@ func parseModifyOperationNFT(args [][]byte, funcName string) *ResponseParseData
 		Operation: funcName,
 	}
 
-	if len(args) < minArgumentsQuantityOperationNFT {
+	if len(args) < minArgumentsModifyOperationNFT {
 		return responseData
 	}
 
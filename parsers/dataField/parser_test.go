@@ -1,18 +1,25 @@
 package datafield
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/hex"
 	"testing"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockArgumentsOperationParser() *ArgsOperationDataFieldParser {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(3, 0)
+
 	return &ArgsOperationDataFieldParser{
-		Marshalizer:   &mock.MarshalizerMock{},
-		AddressLength: 32,
+		Marshalizer:      &mock.MarshalizerMock{},
+		AddressLength:    32,
+		ShardCoordinator: shardCoordinator,
 	}
 }
 
@@ -29,6 +36,16 @@ func TestNewOperationDataFieldParser(t *testing.T) {
 		require.Equal(t, core.ErrNilMarshalizer, err)
 	})
 
+	t.Run("NilShardCoordinator", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgumentsOperationParser()
+		arguments.ShardCoordinator = nil
+
+		_, err := NewOperationDataFieldParser(arguments)
+		require.Equal(t, core.ErrNilShardCoordinator, err)
+	})
+
 	t.Run("ShouldWork", func(t *testing.T) {
 		t.Parallel()
 
@@ -50,7 +67,7 @@ func TestParseQuantityOperationsDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTLocalBurn@4d4949552d616263646566@0102")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTLocalBurn",
 			DCDTValues: []string{"258"},
@@ -62,7 +79,7 @@ func TestParseQuantityOperationsDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTLocalMint@4d4949552d616263646566@1122")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTLocalMint",
 			DCDTValues: []string{"4386"},
@@ -74,7 +91,7 @@ func TestParseQuantityOperationsDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTLocalMint@4d4949552d616263646566")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTLocalMint",
 		}, res)
@@ -91,7 +108,7 @@ func TestParseQuantityOperationsNFT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTNFTCreate@4E46542D316630666638@01@4E46542D31323334@03e8@516d664132487465726e674d6242655467506b3261327a6f4d357965616f33456f61373678513775346d63646947@746167733a746573742c667265652c66756e3b6d657461646174613a5468697320697320612074657374206465736372697074696f6e20666f7220616e20617765736f6d65206e6674@0101")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTNFTCreate",
 			DCDTValues: []string{"1"},
@@ -103,7 +120,7 @@ func TestParseQuantityOperationsNFT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTNFTBurn@5454545454@0102@123456")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTNFTBurn",
 			DCDTValues: []string{"1193046"},
@@ -115,7 +132,7 @@ func TestParseQuantityOperationsNFT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTNFTAddQuantity@5454545454@02@03")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation:  "DCDTNFTAddQuantity",
 			DCDTValues: []string{"3"},
@@ -127,7 +144,7 @@ func TestParseQuantityOperationsNFT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTNFTAddQuantity@54494b4954414b41@02")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTNFTAddQuantity",
 		}, res)
@@ -144,7 +161,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTFreeze@5454545454")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTFreeze",
 			Tokens:    []string{"TTTTT"},
@@ -155,7 +172,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTFreeze@544f4b454e2d616263642d3031")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTFreeze",
 			Tokens:    []string{"TOKEN-abcd-01"},
@@ -166,7 +183,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTWipe@534b4537592d37336262636404")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTWipe",
 			Tokens:    []string{"SKE7Y-73bbcd-04"},
@@ -177,7 +194,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTFreeze")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTFreeze",
 		}, res)
@@ -187,7 +204,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("callMe@01")
-		res := parser.Parse(dataField, sender, receiverSC, 3)
+		res := parser.Parse(dataField, sender, receiverSC)
 		require.Equal(t, &ResponseParseData{
 			Operation: OperationTransfer,
 			Function:  "callMe",
@@ -198,7 +215,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTMetaDataRecreate@414c45582d656561383461@03@5245435245415445444e4654@1d4c@00@746167733a73706963612c7265637265617465643b6d657461646174613a5265637265617465642d4465736372697074696f6e@68747470733a2f2f696d616765732e756e73706c6173682e636f6d2f70686f746f2d313732373731333237343937322d6431643133386561303536393f713d383026773d33333238266175746f3d666f726d6174266669743d63726f702669786c69623d72622d342e302e3326697869643d4d3377784d6a4133664442384d48787761473930627931775957646c664878386647567566444238664878386641253344253344")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: core.DCDTMetaDataRecreate,
 			Tokens:    []string{"ALEX-eea84a-03"},
@@ -208,7 +225,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTMetaDataUpdate@4d49482d656633313762@01@4d594e4654@1964@00@746167733a73706963612c706169642c7361643b6d657461646174613a536f6c6f2d4465736372697074696f6e@54574f")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: core.DCDTMetaDataUpdate,
 			Tokens:    []string{"MIH-ef317b-01"},
@@ -219,7 +236,7 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTSetNewURIs@53434156414e4745522d616635373661@02@68747470733a2f2f697066732e696f2f697066732f516d596a7956366e515932735569617041326b6e396a5175485a6e57694b73577a683937594d664c375267434e47")
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
 			Operation: core.DCDTSetNewURIs,
 			Tokens:    []string{"SCAVANGER-af576a-02"},
@@ -227,6 +244,72 @@ func TestParseBlockingOperationDCDT(t *testing.T) {
 	})
 }
 
+func TestParseModifyOperationsNFT(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	t.Run("DCDTModifyCreator", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTModifyCreator@414c45582d656561383461@03")
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.DCDTModifyCreator,
+			Tokens:    []string{"ALEX-eea84a-03"},
+		}, res)
+	})
+
+	t.Run("DCDTModifyRoyalties", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTModifyRoyalties@414c45582d656561383461@03@1d4c")
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.DCDTModifyRoyalties,
+			Tokens:    []string{"ALEX-eea84a-03"},
+		}, res)
+	})
+
+	t.Run("DCDTModifyCreatorNotEnoughArguments", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("DCDTModifyCreator@414c45582d656561383461")
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation: core.DCDTModifyCreator,
+		}, res)
+	})
+}
+
+func TestParseBuiltInFunctionsOperation(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	builtInFunctions := []string{
+		vmcommon.BuiltInFunctionDeleteUserName,
+		vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress,
+		vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress,
+		vmcommon.DCDTAddMetadata,
+		vmcommon.DCDTDeleteMetadata,
+	}
+	for _, builtInFunction := range builtInFunctions {
+		function := builtInFunction
+		t.Run(function, func(t *testing.T) {
+			t.Parallel()
+
+			dataField := []byte(function + "@4d4949552d616263646566")
+			res := parser.Parse(dataField, sender, sender)
+			require.Equal(t, function, res.Operation)
+		})
+	}
+}
+
 func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 	t.Parallel()
 
@@ -238,9 +321,10 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 
 		dataField := []byte("relayedTx@7b226e6f6e6365223a362c2276616c7565223a302c227265636569766572223a2241414141414141414141414641436e626331733351534939726e6d697a69684d7a3631665539446a71786b3d222c2273656e646572223a2248714b386459464a43474144346a756d4e4e742b314530745a6579736376714c7a38624c47574e774177453d222c226761735072696365223a313030303030303030302c226761734c696d6974223a31353030303030302c2264617461223a2252454e45564652795957357a5a6d56795144517a4e4751305a6a51784d6d517a4f544d794d7a677a4e444d354d7a4a414d444e6c4f4541324d6a63314e7a6b304d7a59344e6a55334d7a6330514745774d4441774d444177222c22636861696e4944223a2252413d3d222c2276657273696f6e223a312c227369676e6174757265223a2262367331755349396f6d4b63514448344337624f534a632f62343166577a3961584d777334526966552b71343870486d315430636f72744b727443484a4258724f67536b3651333254546f7a6e4e2b7074324f4644413d3d227d")
 
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 
 		rcv, _ := hex.DecodeString("0000000000000000050029db735b3741223dae79a2ce284ccfad5f53d0e3ab19")
+		innerSender, _ := base64.StdEncoding.DecodeString("HqK8dYFJCGAD4jumNNt+1E0tZeyscvqLz8bLGWNwAwE=")
 		require.Equal(t, &ResponseParseData{
 			IsRelayed:        true,
 			Operation:        "DCDTTransfer",
@@ -249,6 +333,18 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 			DCDTValues:       []string{"1000"},
 			Receivers:        [][]byte{rcv},
 			ReceiversShardID: []uint32{1},
+			Relayer:          sender,
+			InnerSender:      innerSender,
+			Transfers: []*TransferData{
+				{
+					Token:           "CMOA-928492",
+					Value:           "1000",
+					Receiver:        rcv,
+					ReceiverShardID: 1,
+					Function:        "buyChest",
+					Arguments:       [][]byte{{0xa0, 0, 0, 0}},
+				},
+			},
 		}, res)
 	})
 
@@ -265,13 +361,15 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 			"@" +
 			"01a2")
 
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			IsRelayed:        true,
 			Operation:        OperationTransfer,
 			Function:         "callMe",
 			Receivers:        [][]byte{receiverSC},
 			ReceiversShardID: []uint32{0},
+			Relayer:          sender,
+			InnerSender:      receiver,
 		}, res)
 	})
 
@@ -279,7 +377,7 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte(core.RelayedTransactionV2 + "@abcd")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			IsRelayed: true,
 		}, res)
@@ -289,7 +387,7 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte(core.RelayedTransaction)
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			IsRelayed: true,
 		}, res)
@@ -307,7 +405,7 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 			hex.EncodeToString([]byte(core.RelayedTransaction)) +
 			"@" +
 			"01a2")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			IsRelayed: true,
 		}, res)
@@ -326,8 +424,9 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 			hex.EncodeToString(nftTransferData) +
 			"@" +
 			"01a2")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		rcv, _ := hex.DecodeString("000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483")
+		callArg, _ := hex.DecodeString("00000000000000000500a655b2b534218d6d8cfa1f219960be2f462e92565483")
 		require.Equal(t, &ResponseParseData{
 			IsRelayed:        true,
 			Operation:        "DCDTNFTTransfer",
@@ -336,6 +435,20 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 			Receivers:        [][]byte{rcv},
 			ReceiversShardID: []uint32{1},
 			Function:         "claimRewardsProxy",
+			Relayer:          sender,
+			InnerSender:      receiver,
+			Transfers: []*TransferData{
+				{
+					Token:              "LKFARM-9d1ea8",
+					Nonce:              0x34ae14,
+					Value:              "138495980998569893315957691",
+					Receiver:           rcv,
+					ReceiverShardID:    1,
+					Function:           "claimRewardsProxy",
+					Arguments:          [][]byte{callArg},
+					IsCrossShardSCCall: true,
+				},
+			},
 		}, res)
 	})
 
@@ -343,9 +456,11 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 		t.Parallel()
 
 		dataField := []byte("DCDTNFTCreateRoleTransfer@01010101@020202")
-		res := parser.Parse(dataField, sender, receiver, 3)
+		res := parser.Parse(dataField, sender, receiver)
 		require.Equal(t, &ResponseParseData{
 			Operation: "DCDTNFTCreateRoleTransfer",
+			Tokens:    []string{"\x01\x01\x01\x01"},
+			Roles:     []string{core.DCDTRoleNFTCreate},
 		}, res)
 	})
 }
@@ -360,7 +475,7 @@ func TestParseSCDeploy(t *testing.T) {
 		dataField := []byte("0101020304050607")
 		rcvAddr := make([]byte, 32)
 
-		res := parser.Parse(dataField, sender, rcvAddr, 3)
+		res := parser.Parse(dataField, sender, rcvAddr)
 		require.Equal(t, &ResponseParseData{
 			Operation: operationDeploy,
 		}, res)
@@ -376,9 +491,36 @@ func TestGuardians(t *testing.T) {
 
 		dataField := []byte("SetGuardian")
 
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation:         core.BuiltInFunctionSetGuardian,
+			AffectedAddresses: [][]byte{sender},
+		}, res)
+	})
+
+	t.Run("SetGuardianWithAddress", func(t *testing.T) {
+		t.Parallel()
+
+		guardian := bytes.Repeat([]byte{2}, 32)
+		dataField := []byte("SetGuardian@" + hex.EncodeToString(guardian) + "@" + hex.EncodeToString([]byte("uid")))
+
+		res := parser.Parse(dataField, sender, sender)
+		require.Equal(t, &ResponseParseData{
+			Operation:         core.BuiltInFunctionSetGuardian,
+			AffectedAddresses: [][]byte{sender},
+			GuardianAddress:   guardian,
+		}, res)
+	})
+
+	t.Run("SetGuardianInvalidAddress", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := []byte("SetGuardian@0102@03")
+
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
-			Operation: core.BuiltInFunctionSetGuardian,
+			Operation:         core.BuiltInFunctionSetGuardian,
+			AffectedAddresses: [][]byte{sender},
 		}, res)
 	})
 
@@ -387,9 +529,10 @@ func TestGuardians(t *testing.T) {
 
 		dataField := []byte("GuardAccount")
 
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
-			Operation: core.BuiltInFunctionGuardAccount,
+			Operation:         core.BuiltInFunctionGuardAccount,
+			AffectedAddresses: [][]byte{sender},
 		}, res)
 	})
 
@@ -398,9 +541,10 @@ func TestGuardians(t *testing.T) {
 
 		dataField := []byte("UnGuardAccount")
 
-		res := parser.Parse(dataField, sender, sender, 3)
+		res := parser.Parse(dataField, sender, sender)
 		require.Equal(t, &ResponseParseData{
-			Operation: core.BuiltInFunctionUnGuardAccount,
+			Operation:         core.BuiltInFunctionUnGuardAccount,
+			AffectedAddresses: [][]byte{sender},
 		}, res)
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"unicode"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
 const (
@@ -62,6 +63,13 @@ func getAllBuiltInFunctions() []string {
 		core.DCDTModifyCreator,
 		core.DCDTModifyRoyalties,
 		core.DCDTSetTokenType,
+		vmcommon.BuiltInFunctionDeleteUserName,
+		vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress,
+		vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress,
+		vmcommon.DCDTAddMetadata,
+		vmcommon.DCDTDeleteMetadata,
 	}
 }
 
@@ -1,10 +1,15 @@
 package parsers
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
 type deployArgsParser struct {
+	codec CallDataCodec
 }
 
 // DeployArgs represents the parsed deploy arguments
@@ -15,23 +20,65 @@ type DeployArgs struct {
 	Arguments    [][]byte
 }
 
+// UpgradeArgs represents the arguments of a contract upgrade
+type UpgradeArgs struct {
+	Code         []byte
+	CodeMetadata vmcommon.CodeMetadata
+	Arguments    [][]byte
+}
+
 // NewDeployArgsParser creates a new parser
 func NewDeployArgsParser() *deployArgsParser {
-	return &deployArgsParser{}
+	return &deployArgsParser{
+		codec: NewHexCallDataCodec(),
+	}
+}
+
+// NewStrictDeployArgsParser creates a new parser which also rejects uppercase hex, empty arguments and trailing separators
+func NewStrictDeployArgsParser() *deployArgsParser {
+	return &deployArgsParser{
+		codec: NewStrictHexCallDataCodec(),
+	}
+}
+
+// NewDeployArgsParserWithCodec creates a new parser of the data strings written in the wire format of the provided codec
+func NewDeployArgsParserWithCodec(codec CallDataCodec) (*deployArgsParser, error) {
+	if check.IfNil(codec) {
+		return nil, ErrNilCallDataCodec
+	}
+
+	return &deployArgsParser{
+		codec: codec,
+	}, nil
 }
 
 // ParseData parses strings of the following format:
 // codeHex@vmTypeHex@codeMetadataHex@argFooHex@argBarHex...
 func (parser *deployArgsParser) ParseData(data string) (*DeployArgs, error) {
+	return parser.parseData(data, false)
+}
+
+// ParseAndValidateData parses the data as ParseData does, also rejecting the VM types not having VMTypeLen bytes and
+// the code metadata having an invalid length or unknown flags
+func (parser *deployArgsParser) ParseAndValidateData(data string) (*DeployArgs, error) {
+	return parser.parseData(data, true)
+}
+
+func (parser *deployArgsParser) parseData(data string, shouldValidate bool) (*DeployArgs, error) {
 	result := &DeployArgs{}
 
-	tokens, err := tokenize(data)
+	tokens, err := splitFields(parser.codec, data, ReasonEmptyCode)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(tokens) < minNumDeployArguments {
-		return nil, ErrInvalidDeployArguments
+		return nil, newParseError(parser.codec, tokens, len(tokens), ReasonMissingArgument, ErrInvalidDeployArguments)
+	}
+
+	err = parser.codec.ValidateFields(tokens, indexOfCode)
+	if err != nil {
+		return nil, err
 	}
 
 	result.Code, err = parser.parseCode(tokens)
@@ -39,12 +86,12 @@ func (parser *deployArgsParser) ParseData(data string) (*DeployArgs, error) {
 		return nil, err
 	}
 
-	result.VMType, err = parser.parseVMType(tokens)
+	result.VMType, err = parser.parseVMType(tokens, shouldValidate)
 	if err != nil {
 		return nil, err
 	}
 
-	result.CodeMetadata, err = parser.parseCodeMetadata(tokens)
+	result.CodeMetadata, err = parser.parseCodeMetadata(tokens, shouldValidate)
 	if err != nil {
 		return nil, err
 	}
@@ -58,34 +105,43 @@ func (parser *deployArgsParser) ParseData(data string) (*DeployArgs, error) {
 }
 
 func (parser *deployArgsParser) parseCode(tokens []string) ([]byte, error) {
-	codeHex := tokens[indexOfCode]
-	code, err := decodeToken(codeHex)
+	code, err := parser.codec.DecodeField(tokens, indexOfCode)
 	if err != nil {
-		return nil, ErrInvalidCode
+		return nil, withError(err, ErrInvalidCode)
 	}
 
 	return code, nil
 }
 
-func (parser *deployArgsParser) parseVMType(tokens []string) ([]byte, error) {
-	vmTypeHex := tokens[indexOfVMType]
-	if len(vmTypeHex) == 0 {
-		return nil, ErrInvalidVMType
+func (parser *deployArgsParser) parseVMType(tokens []string, shouldValidate bool) ([]byte, error) {
+	if len(tokens[indexOfVMType]) == 0 {
+		return nil, newParseError(parser.codec, tokens, indexOfVMType, ReasonEmptyArgument, ErrInvalidVMType)
 	}
 
-	vmType, err := decodeToken(vmTypeHex)
+	vmType, err := parser.codec.DecodeField(tokens, indexOfVMType)
 	if err != nil {
-		return nil, ErrInvalidVMType
+		return nil, withError(err, ErrInvalidVMType)
+	}
+	if shouldValidate && len(vmType) != vmcommon.VMTypeLen {
+		return nil, newParseError(parser.codec, tokens, indexOfVMType, ReasonInvalidVMTypeLength, ErrInvalidVMType)
 	}
 
 	return vmType, nil
 }
 
-func (parser *deployArgsParser) parseCodeMetadata(tokens []string) (vmcommon.CodeMetadata, error) {
-	codeMetadataHex := tokens[indexOfCodeMetadata]
-	codeMetadataBytes, err := decodeToken(codeMetadataHex)
+func (parser *deployArgsParser) parseCodeMetadata(tokens []string, shouldValidate bool) (vmcommon.CodeMetadata, error) {
+	codeMetadataBytes, err := parser.codec.DecodeField(tokens, indexOfCodeMetadata)
 	if err != nil {
-		return vmcommon.CodeMetadata{}, ErrInvalidCodeMetadata
+		return vmcommon.CodeMetadata{}, withError(err, ErrInvalidCodeMetadata)
+	}
+	if shouldValidate {
+		err = vmcommon.CheckCodeMetadataBytes(codeMetadataBytes)
+		if errors.Is(err, vmcommon.ErrInvalidCodeMetadataLength) {
+			return vmcommon.CodeMetadata{}, newParseError(parser.codec, tokens, indexOfCodeMetadata, ReasonInvalidCodeMetadataLength, ErrInvalidCodeMetadata)
+		}
+		if err != nil {
+			return vmcommon.CodeMetadata{}, newParseError(parser.codec, tokens, indexOfCodeMetadata, ReasonUnknownCodeMetadataBits, ErrInvalidCodeMetadata)
+		}
 	}
 
 	codeMetadata := vmcommon.CodeMetadataFromBytes(codeMetadataBytes)
@@ -96,7 +152,7 @@ func (parser *deployArgsParser) parseArguments(tokens []string) ([][]byte, error
 	arguments := make([][]byte, 0)
 
 	for i := startIndexOfConstructorArguments; i < len(tokens); i++ {
-		argument, err := decodeToken(tokens[i])
+		argument, err := parser.codec.DecodeField(tokens, i)
 		if err != nil {
 			return nil, err
 		}
@@ -107,6 +163,51 @@ func (parser *deployArgsParser) parseArguments(tokens []string) ([][]byte, error
 	return arguments, nil
 }
 
+// CreateDeployData creates the data of a contract deploy, the inverse of ParseData:
+// codeHex@vmTypeHex@codeMetadataHex@argFooHex@argBarHex...
+func (parser *deployArgsParser) CreateDeployData(args *DeployArgs) (string, error) {
+	if args == nil {
+		return "", ErrNilDeployArgs
+	}
+	if len(args.VMType) != vmcommon.VMTypeLen {
+		return "", fmt.Errorf("%w, expected length %d, got %d", ErrInvalidVMType, vmcommon.VMTypeLen, len(args.VMType))
+	}
+
+	fields := make([]string, 0, startIndexOfConstructorArguments+len(args.Arguments))
+	fields = append(fields,
+		parser.codec.EncodeField(args.Code),
+		parser.codec.EncodeField(args.VMType),
+		parser.codec.EncodeField(args.CodeMetadata.ToBytes()),
+	)
+
+	return parser.joinWithArguments(fields, args.Arguments), nil
+}
+
+// CreateUpgradeData creates the data of a contract upgrade:
+// upgradeContract@codeHex@codeMetadataHex@argFooHex@argBarHex...
+func (parser *deployArgsParser) CreateUpgradeData(args *UpgradeArgs) (string, error) {
+	if args == nil {
+		return "", ErrNilDeployArgs
+	}
+
+	fields := make([]string, 0, startIndexOfConstructorArguments+len(args.Arguments))
+	fields = append(fields,
+		vmcommon.UpgradeContractFunctionName,
+		parser.codec.EncodeField(args.Code),
+		parser.codec.EncodeField(args.CodeMetadata.ToBytes()),
+	)
+
+	return parser.joinWithArguments(fields, args.Arguments), nil
+}
+
+func (parser *deployArgsParser) joinWithArguments(fields []string, arguments [][]byte) string {
+	for _, argument := range arguments {
+		fields = append(fields, parser.codec.EncodeField(argument))
+	}
+
+	return parser.codec.JoinFields(fields)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (parser *deployArgsParser) IsInterfaceNil() bool {
 	return parser == nil
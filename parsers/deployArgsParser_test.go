@@ -1,8 +1,10 @@
 package parsers
 
 import (
+	"errors"
 	"testing"
 
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,34 +38,115 @@ func TestDeployArgsParser_ParseDataWhenErrorneousInput(t *testing.T) {
 	require.NotNil(t, parser)
 
 	parsed, err := parser.ParseData("")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyCode)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("@aaaa")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyCode)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("ABBA@A")
-	require.Equal(t, ErrInvalidDeployArguments, err)
+	requireParseError(t, err, ErrInvalidDeployArguments, 2, ReasonMissingArgument)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("XYZY@A@A")
-	require.Equal(t, ErrInvalidCode, err)
+	requireParseError(t, err, ErrInvalidCode, 0, ReasonInvalidHexCharacter)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("ABBA@A@A")
-	require.Equal(t, ErrInvalidVMType, err)
+	requireParseError(t, err, ErrInvalidVMType, 1, ReasonOddHexLength)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("ABBA@@A")
-	require.Equal(t, ErrInvalidVMType, err)
+	requireParseError(t, err, ErrInvalidVMType, 1, ReasonEmptyArgument)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("ABBA@ABBA@A")
-	require.Equal(t, ErrInvalidCodeMetadata, err)
+	requireParseError(t, err, ErrInvalidCodeMetadata, 2, ReasonOddHexLength)
 	require.Nil(t, parsed)
 
 	parsed, err = parser.ParseData("ABBA@ABBA@ABBA@A")
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 3, ReasonOddHexLength)
 	require.Nil(t, parsed)
 }
+
+func TestDeployArgsParser_ParseAndValidateData(t *testing.T) {
+	t.Parallel()
+
+	parser := NewDeployArgsParser()
+
+	parsed, err := parser.ParseAndValidateData("abba@0500@0506@64")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xAB, 0xBA}, parsed.Code)
+	require.Equal(t, []byte{0x05, 0x00}, parsed.VMType)
+	require.Equal(t, vmcommon.CodeMetadata{Upgradeable: true, Readable: true, Payable: true, PayableBySC: true}, parsed.CodeMetadata)
+	require.Equal(t, [][]byte{{100}}, parsed.Arguments)
+
+	_, err = parser.ParseAndValidateData("abba@050000@0100")
+	requireParseError(t, err, ErrInvalidVMType, 1, ReasonInvalidVMTypeLength)
+
+	_, err = parser.ParseAndValidateData("abba@0500@010000")
+	requireParseError(t, err, ErrInvalidCodeMetadata, 2, ReasonInvalidCodeMetadataLength)
+
+	_, err = parser.ParseAndValidateData("abba@0500@")
+	requireParseError(t, err, ErrInvalidCodeMetadata, 2, ReasonInvalidCodeMetadataLength)
+
+	_, err = parser.ParseAndValidateData("abba@0500@0201")
+	requireParseError(t, err, ErrInvalidCodeMetadata, 2, ReasonUnknownCodeMetadataBits)
+
+	parsed, err = parser.ParseData("abba@0500@0201")
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.CodeMetadata{}, parsed.CodeMetadata)
+}
+
+func TestDeployArgsParser_CreateDeployData(t *testing.T) {
+	t.Parallel()
+
+	parser := NewDeployArgsParser()
+
+	data, err := parser.CreateDeployData(nil)
+	require.Equal(t, ErrNilDeployArgs, err)
+	require.Empty(t, data)
+
+	data, err = parser.CreateDeployData(&DeployArgs{Code: []byte{0xAB}, VMType: []byte{5}})
+	require.True(t, errors.Is(err, ErrInvalidVMType))
+	require.Empty(t, data)
+
+	args := &DeployArgs{
+		Code:         []byte{0xAB, 0xBA},
+		VMType:       []byte{0x05, 0x00},
+		CodeMetadata: vmcommon.CodeMetadata{Upgradeable: true, Payable: true},
+		Arguments:    [][]byte{{100}, {}},
+	}
+	data, err = parser.CreateDeployData(args)
+	require.Nil(t, err)
+	require.Equal(t, "abba@0500@0102@64@", data)
+
+	parsed, err := parser.ParseAndValidateData(data)
+	require.Nil(t, err)
+	require.Equal(t, args, parsed)
+}
+
+func TestDeployArgsParser_CreateUpgradeData(t *testing.T) {
+	t.Parallel()
+
+	parser := NewDeployArgsParser()
+
+	data, err := parser.CreateUpgradeData(nil)
+	require.Equal(t, ErrNilDeployArgs, err)
+	require.Empty(t, data)
+
+	data, err = parser.CreateUpgradeData(&UpgradeArgs{
+		Code:         []byte{0xAB, 0xBA},
+		CodeMetadata: vmcommon.CodeMetadata{Readable: true},
+		Arguments:    [][]byte{{100}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "upgradeContract@abba@0400@64", data)
+
+	function, arguments, err := NewCallArgsParser().ParseData(data)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.UpgradeContractFunctionName, function)
+	require.Equal(t, [][]byte{{0xAB, 0xBA}, {0x04, 0x00}, {100}}, arguments)
+	require.Nil(t, vmcommon.CheckCodeMetadataBytes(arguments[1]))
+}
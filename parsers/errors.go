@@ -33,3 +33,21 @@ var ErrNotEnoughArguments = errors.New("not enough arguments")
 
 // ErrNilMarshalizer signals that marshaller is nil
 var ErrNilMarshalizer = errors.New("nil marshaller")
+
+// ErrNilCallDataCodec signals that a nil call data codec was provided
+var ErrNilCallDataCodec = errors.New("nil call data codec")
+
+// ErrNilDeployArgs signals that nil deploy arguments were provided
+var ErrNilDeployArgs = errors.New("nil deploy arguments")
+
+// ErrNilOutputAccount signals that a nil output account was provided
+var ErrNilOutputAccount = errors.New("nil output account")
+
+// ErrNilStorageReader signals that a nil storage reader was provided
+var ErrNilStorageReader = errors.New("nil storage reader")
+
+// ErrNilStorageDiff signals that a nil storage diff was provided
+var ErrNilStorageDiff = errors.New("nil storage diff")
+
+// ErrStorageDiffMismatch signals that the storage value before the diff does not match the current storage value
+var ErrStorageDiffMismatch = errors.New("storage diff mismatch")
@@ -0,0 +1,100 @@
+package parsers
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var _ CallDataCodec = (*hexCallDataCodec)(nil)
+
+// hexCallDataCodec is the default wire format, holding "@" separated, hex encoded fields
+type hexCallDataCodec struct {
+	isStrict bool
+}
+
+// NewHexCallDataCodec creates the codec of the "@" separated, hex encoded data strings
+func NewHexCallDataCodec() *hexCallDataCodec {
+	return &hexCallDataCodec{}
+}
+
+// NewStrictHexCallDataCodec creates the codec of the "@" separated, hex encoded data strings which also rejects
+// uppercase hex, empty fields and trailing separators
+func NewStrictHexCallDataCodec() *hexCallDataCodec {
+	return &hexCallDataCodec{
+		isStrict: true,
+	}
+}
+
+// SplitFields splits the data by the "@" separator
+func (codec *hexCallDataCodec) SplitFields(data string) ([]string, error) {
+	return strings.Split(data, atSeparator), nil
+}
+
+// ValidateFields rejects, in strict mode, the trailing separators and the empty fields starting with the provided index
+func (codec *hexCallDataCodec) ValidateFields(fields []string, startIndex int) error {
+	if !codec.isStrict {
+		return nil
+	}
+
+	lastIndex := len(fields) - 1
+	if lastIndex > 0 && len(fields[lastIndex]) == 0 {
+		return newParseError(codec, fields, lastIndex, ReasonTrailingSeparator, ErrTokenizeFailed)
+	}
+
+	for i := startIndex; i < len(fields); i++ {
+		if len(fields[i]) == 0 {
+			return newParseError(codec, fields, i, ReasonEmptyArgument, ErrTokenizeFailed)
+		}
+	}
+
+	return nil
+}
+
+// DecodeField hex decodes the field found at the provided index
+func (codec *hexCallDataCodec) DecodeField(fields []string, index int) ([]byte, error) {
+	field := fields[index]
+	if codec.isStrict && strings.ContainsAny(field, uppercaseHexDigits) {
+		return nil, newParseError(codec, fields, index, ReasonUppercaseHex, ErrTokenizeFailed)
+	}
+
+	decoded, err := hex.DecodeString(field)
+	if errors.Is(err, hex.ErrLength) {
+		return nil, newParseError(codec, fields, index, ReasonOddHexLength, ErrTokenizeFailed)
+	}
+	if err != nil {
+		return nil, newParseError(codec, fields, index, ReasonInvalidHexCharacter, ErrTokenizeFailed)
+	}
+
+	return decoded, nil
+}
+
+// EncodeField hex encodes the field
+func (codec *hexCallDataCodec) EncodeField(field []byte) string {
+	return hex.EncodeToString(field)
+}
+
+// JoinFields joins the fields with the "@" separator
+func (codec *hexCallDataCodec) JoinFields(fields []string) string {
+	return strings.Join(fields, atSeparator)
+}
+
+// FieldOffset returns the position in the data of the first character of the field found at the provided index
+func (codec *hexCallDataCodec) FieldOffset(fields []string, index int) int {
+	offset := 0
+	for i := 0; i < index && i < len(fields); i++ {
+		offset += len(fields[i]) + len(atSeparator)
+	}
+
+	return offset
+}
+
+// IsStrict returns true if the codec rejects uppercase hex, empty fields and trailing separators
+func (codec *hexCallDataCodec) IsStrict() bool {
+	return codec.isStrict
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (codec *hexCallDataCodec) IsInterfaceNil() bool {
+	return codec == nil
+}
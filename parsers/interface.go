@@ -0,0 +1,27 @@
+package parsers
+
+// CallDataCodec defines the wire format of the data strings handled by the parsers: how the data is split into
+// fields and how every field is encoded
+type CallDataCodec interface {
+	// SplitFields splits the data into its still encoded fields
+	SplitFields(data string) ([]string, error)
+	// ValidateFields checks the fields starting with the provided index against the rules of the wire format
+	ValidateFields(fields []string, startIndex int) error
+	// DecodeField decodes the field found at the provided index
+	DecodeField(fields []string, index int) ([]byte, error)
+	// EncodeField encodes a single field
+	EncodeField(field []byte) string
+	// JoinFields joins the encoded fields into the data
+	JoinFields(fields []string) string
+	// FieldOffset returns the position in the data where the field found at the provided index starts
+	FieldOffset(fields []string, index int) int
+	// IsStrict returns true if the codec rejects the loosely formatted fields accepted by the default parsers
+	IsStrict() bool
+	IsInterfaceNil() bool
+}
+
+// StorageReader returns the current storage values of the accounts
+type StorageReader interface {
+	GetStorageData(accountAddress []byte, index []byte) ([]byte, uint32, error)
+	IsInterfaceNil() bool
+}
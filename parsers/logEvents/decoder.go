@@ -0,0 +1,303 @@
+package logevents
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+const (
+	tokenIDTopicIndex    = 0
+	nonceTopicIndex      = 1
+	valueTopicIndex      = 2
+	firstExtraTopicIndex = 3
+	numTokenTopics       = 3
+)
+
+type decodeFunc func(entry *vmcommon.LogEntry) (Event, error)
+
+type logsDecoder struct {
+	marshaller vmcommon.Marshalizer
+	decoders   map[string]decodeFunc
+}
+
+// NewLogsDecoder creates a component able to decode the DCDT log entries emitted by the built-in functions
+func NewLogsDecoder(marshaller vmcommon.Marshalizer) (*logsDecoder, error) {
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+
+	ld := &logsDecoder{
+		marshaller: marshaller,
+	}
+	ld.decoders = map[string]decodeFunc{
+		core.BuiltInFunctionDCDTTransfer:            ld.decodeTransfer,
+		core.BuiltInFunctionDCDTNFTTransfer:         ld.decodeTransfer,
+		core.BuiltInFunctionMultiDCDTNFTTransfer:    ld.decodeTransfer,
+		core.BuiltInFunctionDCDTBurn:                ld.decodeBurn,
+		core.BuiltInFunctionDCDTLocalBurn:           ld.decodeBurn,
+		core.BuiltInFunctionDCDTNFTBurn:             ld.decodeBurn,
+		core.BuiltInFunctionDCDTLocalMint:           ld.decodeMint,
+		core.BuiltInFunctionDCDTNFTAddQuantity:      ld.decodeMint,
+		core.BuiltInFunctionDCDTWipe:                ld.decodeWipe,
+		core.BuiltInFunctionDCDTFreeze:              ld.decodeFreeze,
+		core.BuiltInFunctionDCDTUnFreeze:            ld.decodeFreeze,
+		core.BuiltInFunctionDCDTNFTCreate:           ld.decodeNFTCreate,
+		core.DCDTMetaDataRecreate:                   ld.decodeMetaDataUpdate,
+		core.DCDTMetaDataUpdate:                     ld.decodeMetaDataUpdate,
+		core.BuiltInFunctionDCDTNFTUpdateAttributes: ld.decodeAttributesUpdate,
+		core.BuiltInFunctionDCDTNFTAddURI:           ld.decodeURIs,
+		core.DCDTSetNewURIs:                         ld.decodeURIs,
+		core.DCDTModifyRoyalties:                    ld.decodeRoyalties,
+		core.DCDTModifyCreator:                      ld.decodeCreator,
+		core.BuiltInFunctionSetDCDTRole:             ld.decodeRoles,
+		core.BuiltInFunctionUnSetDCDTRole:           ld.decodeRoles,
+	}
+
+	return ld, nil
+}
+
+// Decode returns the typed event of the provided log entry, based on its identifier
+func (ld *logsDecoder) Decode(entry *vmcommon.LogEntry) (Event, error) {
+	if entry == nil {
+		return nil, ErrNilLogEntry
+	}
+
+	decode, found := ld.decoders[string(entry.Identifier)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownIdentifier, entry.Identifier)
+	}
+
+	return decode(entry)
+}
+
+// DecodeLogs returns the typed events of the provided log entries. Log entries with an unknown identifier are skipped.
+func (ld *logsDecoder) DecodeLogs(entries []*vmcommon.LogEntry) ([]Event, error) {
+	events := make([]Event, 0, len(entries))
+	for _, entry := range entries {
+		event, err := ld.Decode(entry)
+		if errors.Is(err, ErrUnknownIdentifier) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (ld *logsDecoder) decodeTransfer(entry *vmcommon.LogEntry) (Event, error) {
+	numTopics := len(entry.Topics)
+	if numTopics < numTokenTopics+1 || (numTopics-1)%numTokenTopics != 0 {
+		return nil, newInvalidNumberOfTopicsError(entry)
+	}
+
+	tokens := make([]*TokenData, 0, numTopics/numTokenTopics)
+	for i := 0; i < numTopics-1; i += numTokenTopics {
+		tokens = append(tokens, decodeTokenData(entry.Topics[i:]))
+	}
+
+	return &TransferEvent{
+		BaseEvent:   newBaseEvent(entry),
+		Tokens:      tokens,
+		Destination: entry.Topics[numTopics-1],
+		Data:        entry.Data,
+	}, nil
+}
+
+func (ld *logsDecoder) decodeBurn(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BurnEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+	}, nil
+}
+
+func (ld *logsDecoder) decodeMint(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MintEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+	}, nil
+}
+
+func (ld *logsDecoder) decodeWipe(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WipeEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+		Account:   entry.Topics[firstExtraTopicIndex],
+	}, nil
+}
+
+func (ld *logsDecoder) decodeFreeze(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FreezeEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+		Account:   entry.Topics[firstExtraTopicIndex],
+		Frozen:    string(entry.Identifier) == core.BuiltInFunctionDCDTFreeze,
+	}, nil
+}
+
+func (ld *logsDecoder) decodeNFTCreate(entry *vmcommon.LogEntry) (Event, error) {
+	token, err := ld.decodeToken(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTCreateEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+		Token:     token,
+	}, nil
+}
+
+func (ld *logsDecoder) decodeMetaDataUpdate(entry *vmcommon.LogEntry) (Event, error) {
+	token, err := ld.decodeToken(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetaDataUpdateEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+		Token:     token,
+	}, nil
+}
+
+func (ld *logsDecoder) decodeToken(entry *vmcommon.LogEntry) (*dcdt.DCDigitalToken, error) {
+	err := checkNumTopics(entry, numTokenTopics+1)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &dcdt.DCDigitalToken{}
+	err = ld.marshaller.Unmarshal(token, entry.Topics[firstExtraTopicIndex])
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the token data of %s", err, entry.Identifier)
+	}
+
+	return token, nil
+}
+
+func (ld *logsDecoder) decodeAttributesUpdate(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttributesUpdateEvent{
+		BaseEvent:  newBaseEvent(entry),
+		TokenData:  *decodeTokenData(entry.Topics),
+		Attributes: entry.Topics[firstExtraTopicIndex],
+	}, nil
+}
+
+func (ld *logsDecoder) decodeURIs(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &URIsEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+		URIs:      entry.Topics[firstExtraTopicIndex:],
+	}, nil
+}
+
+func (ld *logsDecoder) decodeRoyalties(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RoyaltiesEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+		Royalties: uint32(big.NewInt(0).SetBytes(entry.Topics[firstExtraTopicIndex]).Uint64()),
+	}, nil
+}
+
+func (ld *logsDecoder) decodeCreator(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatorEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenData: *decodeTokenData(entry.Topics),
+	}, nil
+}
+
+func (ld *logsDecoder) decodeRoles(entry *vmcommon.LogEntry) (Event, error) {
+	err := checkNumTopics(entry, numTokenTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RolesEvent{
+		BaseEvent: newBaseEvent(entry),
+		TokenID:   entry.Topics[tokenIDTopicIndex],
+		Roles:     entry.Topics[firstExtraTopicIndex:],
+		Set:       string(entry.Identifier) == core.BuiltInFunctionSetDCDTRole,
+	}, nil
+}
+
+func newBaseEvent(entry *vmcommon.LogEntry) BaseEvent {
+	return BaseEvent{
+		Identifier: string(entry.Identifier),
+		Address:    entry.Address,
+	}
+}
+
+func decodeTokenData(topics [][]byte) *TokenData {
+	return &TokenData{
+		TokenID: topics[tokenIDTopicIndex],
+		Nonce:   big.NewInt(0).SetBytes(topics[nonceTopicIndex]).Uint64(),
+		Value:   big.NewInt(0).SetBytes(topics[valueTopicIndex]),
+	}
+}
+
+func checkNumTopics(entry *vmcommon.LogEntry, minNumTopics int) error {
+	if len(entry.Topics) < minNumTopics {
+		return newInvalidNumberOfTopicsError(entry)
+	}
+
+	return nil
+}
+
+func newInvalidNumberOfTopicsError(entry *vmcommon.LogEntry) error {
+	return fmt.Errorf("%w for %s: %d", ErrInvalidNumberOfTopics, entry.Identifier, len(entry.Topics))
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ld *logsDecoder) IsInterfaceNil() bool {
+	return ld == nil
+}
@@ -0,0 +1,237 @@
+package logevents
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	caller   = []byte("caller")
+	receiver = []byte("receiver")
+	tokenID  = []byte("TKN-abcdef")
+)
+
+func createDecoder(t *testing.T) *logsDecoder {
+	ld, err := NewLogsDecoder(&mock.MarshalizerMock{})
+	require.Nil(t, err)
+
+	return ld
+}
+
+func createEntry(identifier string, nonce uint64, value int64, address []byte, extraTopics ...[]byte) *vmcommon.LogEntry {
+	topics := [][]byte{tokenID, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(value).Bytes()}
+
+	return &vmcommon.LogEntry{
+		Identifier: []byte(identifier),
+		Address:    address,
+		Topics:     append(topics, extraTopics...),
+	}
+}
+
+func TestNewLogsDecoder(t *testing.T) {
+	t.Parallel()
+
+	ld, err := NewLogsDecoder(nil)
+	assert.Nil(t, ld)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	ld, err = NewLogsDecoder(&mock.MarshalizerMock{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(ld))
+}
+
+func TestLogsDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		event, err := createDecoder(t).Decode(nil)
+		assert.Nil(t, event)
+		assert.Equal(t, ErrNilLogEntry, err)
+	})
+	t.Run("unknown identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		event, err := createDecoder(t).Decode(&vmcommon.LogEntry{Identifier: []byte(core.BuiltInFunctionSetGuardian)})
+		assert.Nil(t, event)
+		assert.True(t, errors.Is(err, ErrUnknownIdentifier))
+	})
+	t.Run("not enough topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		entry := &vmcommon.LogEntry{
+			Identifier: []byte(core.BuiltInFunctionDCDTBurn),
+			Topics:     [][]byte{tokenID},
+		}
+		event, err := createDecoder(t).Decode(entry)
+		assert.Nil(t, event)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfTopics))
+	})
+	t.Run("transfer", func(t *testing.T) {
+		t.Parallel()
+
+		entry := createEntry(core.BuiltInFunctionDCDTNFTTransfer, 2, 10, caller, receiver)
+		entry.Data = vmcommon.FormatLogDataForCall("", core.BuiltInFunctionDCDTNFTTransfer, nil)
+		event, err := createDecoder(t).Decode(entry)
+		require.Nil(t, err)
+
+		expected := &TransferEvent{
+			BaseEvent:   BaseEvent{Identifier: core.BuiltInFunctionDCDTNFTTransfer, Address: caller},
+			Tokens:      []*TokenData{{TokenID: tokenID, Nonce: 2, Value: big.NewInt(10)}},
+			Destination: receiver,
+			Data:        entry.Data,
+		}
+		assert.Equal(t, expected, event)
+	})
+	t.Run("multi transfer", func(t *testing.T) {
+		t.Parallel()
+
+		otherToken := []byte("OTHER-abcdef")
+		entry := createEntry(core.BuiltInFunctionMultiDCDTNFTTransfer, 0, 10, caller, otherToken, []byte{3}, []byte{5}, receiver)
+		event, err := createDecoder(t).Decode(entry)
+		require.Nil(t, err)
+
+		transferEvent := event.(*TransferEvent)
+		assert.Equal(t, receiver, transferEvent.Destination)
+		assert.Equal(t, []*TokenData{
+			{TokenID: tokenID, Nonce: 0, Value: big.NewInt(10)},
+			{TokenID: otherToken, Nonce: 3, Value: big.NewInt(5)},
+		}, transferEvent.Tokens)
+	})
+	t.Run("transfer with uneven topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		entry := createEntry(core.BuiltInFunctionMultiDCDTNFTTransfer, 0, 10, caller, []byte("OTHER-abcdef"), receiver)
+		_, err := createDecoder(t).Decode(entry)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfTopics))
+	})
+	t.Run("burn and mint", func(t *testing.T) {
+		t.Parallel()
+
+		ld := createDecoder(t)
+		event, err := ld.Decode(createEntry(core.BuiltInFunctionDCDTLocalBurn, 0, 7, caller))
+		require.Nil(t, err)
+		assert.Equal(t, &BurnEvent{
+			BaseEvent: BaseEvent{Identifier: core.BuiltInFunctionDCDTLocalBurn, Address: caller},
+			TokenData: TokenData{TokenID: tokenID, Value: big.NewInt(7)},
+		}, event)
+
+		event, err = ld.Decode(createEntry(core.BuiltInFunctionDCDTNFTAddQuantity, 4, 7, caller))
+		require.Nil(t, err)
+		assert.Equal(t, &MintEvent{
+			BaseEvent: BaseEvent{Identifier: core.BuiltInFunctionDCDTNFTAddQuantity, Address: caller},
+			TokenData: TokenData{TokenID: tokenID, Nonce: 4, Value: big.NewInt(7)},
+		}, event)
+	})
+	t.Run("wipe and freeze", func(t *testing.T) {
+		t.Parallel()
+
+		ld := createDecoder(t)
+		event, err := ld.Decode(createEntry(core.BuiltInFunctionDCDTWipe, 1, 3, caller, receiver))
+		require.Nil(t, err)
+		assert.Equal(t, receiver, event.(*WipeEvent).Account)
+		assert.Equal(t, big.NewInt(3), event.(*WipeEvent).Value)
+
+		event, err = ld.Decode(createEntry(core.BuiltInFunctionDCDTFreeze, 0, 3, caller, receiver))
+		require.Nil(t, err)
+		assert.True(t, event.(*FreezeEvent).Frozen)
+		assert.Equal(t, receiver, event.(*FreezeEvent).Account)
+
+		event, err = ld.Decode(createEntry(core.BuiltInFunctionDCDTUnFreeze, 0, 3, caller, receiver))
+		require.Nil(t, err)
+		assert.False(t, event.(*FreezeEvent).Frozen)
+	})
+	t.Run("nft create and metadata update", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &mock.MarshalizerMock{}
+		token := &dcdt.DCDigitalToken{
+			Type:          uint32(core.NonFungible),
+			Value:         big.NewInt(1),
+			TokenMetaData: &dcdt.MetaData{Nonce: 1, Name: []byte("name"), Creator: caller},
+		}
+		tokenBytes, _ := marshaller.Marshal(token)
+
+		ld := createDecoder(t)
+		event, err := ld.Decode(createEntry(core.BuiltInFunctionDCDTNFTCreate, 1, 1, caller, tokenBytes))
+		require.Nil(t, err)
+		assert.Equal(t, token, event.(*NFTCreateEvent).Token)
+		assert.Equal(t, uint64(1), event.(*NFTCreateEvent).Nonce)
+
+		event, err = ld.Decode(createEntry(core.DCDTMetaDataRecreate, 1, 0, caller, tokenBytes))
+		require.Nil(t, err)
+		assert.Equal(t, token, event.(*MetaDataUpdateEvent).Token)
+
+		_, err = ld.Decode(createEntry(core.DCDTMetaDataUpdate, 1, 0, caller, []byte("invalid")))
+		assert.NotNil(t, err)
+	})
+	t.Run("metadata changes", func(t *testing.T) {
+		t.Parallel()
+
+		ld := createDecoder(t)
+		event, err := ld.Decode(createEntry(core.BuiltInFunctionDCDTNFTUpdateAttributes, 1, 0, caller, []byte("attributes")))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("attributes"), event.(*AttributesUpdateEvent).Attributes)
+
+		event, err = ld.Decode(createEntry(core.DCDTSetNewURIs, 1, 0, caller, []byte("uri1"), []byte("uri2")))
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("uri1"), []byte("uri2")}, event.(*URIsEvent).URIs)
+
+		event, err = ld.Decode(createEntry(core.DCDTModifyRoyalties, 1, 0, caller, big.NewInt(500).Bytes()))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(500), event.(*RoyaltiesEvent).Royalties)
+
+		event, err = ld.Decode(createEntry(core.DCDTModifyCreator, 1, 0, caller))
+		require.Nil(t, err)
+		assert.Equal(t, caller, event.(*CreatorEvent).GetAddress())
+	})
+	t.Run("roles", func(t *testing.T) {
+		t.Parallel()
+
+		ld := createDecoder(t)
+		roles := []byte(core.DCDTRoleLocalMint)
+		event, err := ld.Decode(createEntry(core.BuiltInFunctionSetDCDTRole, 0, 0, receiver, roles))
+		require.Nil(t, err)
+		assert.Equal(t, &RolesEvent{
+			BaseEvent: BaseEvent{Identifier: core.BuiltInFunctionSetDCDTRole, Address: receiver},
+			TokenID:   tokenID,
+			Roles:     [][]byte{roles},
+			Set:       true,
+		}, event)
+
+		event, err = ld.Decode(createEntry(core.BuiltInFunctionUnSetDCDTRole, 0, 0, receiver, roles))
+		require.Nil(t, err)
+		assert.False(t, event.(*RolesEvent).Set)
+	})
+}
+
+func TestLogsDecoder_DecodeLogs(t *testing.T) {
+	t.Parallel()
+
+	ld := createDecoder(t)
+	entries := []*vmcommon.LogEntry{
+		createEntry(core.BuiltInFunctionDCDTTransfer, 0, 10, caller, receiver),
+		{Identifier: []byte(core.BuiltInFunctionSetGuardian)},
+		createEntry(core.BuiltInFunctionDCDTBurn, 0, 10, caller),
+	}
+	events, err := ld.DecodeLogs(entries)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, core.BuiltInFunctionDCDTTransfer, events[0].GetIdentifier())
+	assert.Equal(t, core.BuiltInFunctionDCDTBurn, events[1].GetIdentifier())
+
+	entries = append(entries, &vmcommon.LogEntry{Identifier: []byte(core.BuiltInFunctionDCDTWipe)})
+	events, err = ld.DecodeLogs(entries)
+	assert.Nil(t, events)
+	assert.True(t, errors.Is(err, ErrInvalidNumberOfTopics))
+}
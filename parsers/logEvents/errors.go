@@ -0,0 +1,15 @@
+package logevents
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilLogEntry signals that a nil log entry has been provided
+var ErrNilLogEntry = errors.New("nil log entry")
+
+// ErrUnknownIdentifier signals that the log identifier has no registered decoder
+var ErrUnknownIdentifier = errors.New("unknown log identifier")
+
+// ErrInvalidNumberOfTopics signals that the log entry does not have the expected number of topics
+var ErrInvalidNumberOfTopics = errors.New("invalid number of topics")
@@ -0,0 +1,120 @@
+package logevents
+
+import (
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+)
+
+// Event is the common interface of all the decoded events
+type Event interface {
+	GetIdentifier() string
+	GetAddress() []byte
+}
+
+// BaseEvent holds the log identifier and the address which emitted the log
+type BaseEvent struct {
+	Identifier string
+	Address    []byte
+}
+
+// GetIdentifier returns the log identifier, which is the name of the built-in function
+func (event *BaseEvent) GetIdentifier() string {
+	return event.Identifier
+}
+
+// GetAddress returns the address of the log entry
+func (event *BaseEvent) GetAddress() []byte {
+	return event.Address
+}
+
+// TokenData holds the token identifier, nonce and value found in the first topics of a DCDT log entry
+type TokenData struct {
+	TokenID []byte
+	Nonce   uint64
+	Value   *big.Int
+}
+
+// TransferEvent is emitted by DCDTTransfer, DCDTNFTTransfer and MultiDCDTNFTTransfer. Address is the sender.
+type TransferEvent struct {
+	BaseEvent
+	Tokens      []*TokenData
+	Destination []byte
+	Data        [][]byte
+}
+
+// BurnEvent is emitted by DCDTBurn, DCDTLocalBurn and DCDTNFTBurn. Address is the caller.
+type BurnEvent struct {
+	BaseEvent
+	TokenData
+}
+
+// MintEvent is emitted by DCDTLocalMint and DCDTNFTAddQuantity. Address is the caller.
+type MintEvent struct {
+	BaseEvent
+	TokenData
+}
+
+// WipeEvent is emitted by DCDTWipe. Address is the caller and Value holds the wiped amount.
+type WipeEvent struct {
+	BaseEvent
+	TokenData
+	Account []byte
+}
+
+// FreezeEvent is emitted by DCDTFreeze and DCDTUnFreeze. Address is the caller and Value holds the account balance.
+type FreezeEvent struct {
+	BaseEvent
+	TokenData
+	Account []byte
+	Frozen  bool
+}
+
+// NFTCreateEvent is emitted by DCDTNFTCreate. Address is the creator and Value holds the created quantity.
+type NFTCreateEvent struct {
+	BaseEvent
+	TokenData
+	Token *dcdt.DCDigitalToken
+}
+
+// MetaDataUpdateEvent is emitted by DCDTMetaDataRecreate and DCDTMetaDataUpdate. Token holds the new token data.
+type MetaDataUpdateEvent struct {
+	BaseEvent
+	TokenData
+	Token *dcdt.DCDigitalToken
+}
+
+// AttributesUpdateEvent is emitted by DCDTNFTUpdateAttributes
+type AttributesUpdateEvent struct {
+	BaseEvent
+	TokenData
+	Attributes []byte
+}
+
+// URIsEvent is emitted by DCDTNFTAddURI, with the added URIs, and by DCDTSetNewURIs, with the new URIs
+type URIsEvent struct {
+	BaseEvent
+	TokenData
+	URIs [][]byte
+}
+
+// RoyaltiesEvent is emitted by DCDTModifyRoyalties
+type RoyaltiesEvent struct {
+	BaseEvent
+	TokenData
+	Royalties uint32
+}
+
+// CreatorEvent is emitted by DCDTModifyCreator. Address is the new creator.
+type CreatorEvent struct {
+	BaseEvent
+	TokenData
+}
+
+// RolesEvent is emitted by DCDTSetRole and DCDTUnSetRole. Address is the account whose roles changed.
+type RolesEvent struct {
+	BaseEvent
+	TokenID []byte
+	Roles   [][]byte
+	Set     bool
+}
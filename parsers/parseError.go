@@ -0,0 +1,83 @@
+package parsers
+
+import "fmt"
+
+// ParseErrorReason describes why a token of the data string is malformed
+type ParseErrorReason string
+
+const (
+	// ReasonEmptyFunction signals that the data string does not start with a function name
+	ReasonEmptyFunction ParseErrorReason = "empty function"
+	// ReasonEmptyCode signals that the data string does not start with the code
+	ReasonEmptyCode ParseErrorReason = "empty code"
+	// ReasonEmptyKey signals that the data string does not start with a storage key
+	ReasonEmptyKey ParseErrorReason = "empty key"
+	// ReasonOddHexLength signals that the hex encoded token has an odd length
+	ReasonOddHexLength ParseErrorReason = "odd hex length"
+	// ReasonInvalidHexCharacter signals that the token holds a character which is not a hex digit
+	ReasonInvalidHexCharacter ParseErrorReason = "invalid hex character"
+	// ReasonUppercaseHex signals that the token holds uppercase hex digits, rejected in strict mode
+	ReasonUppercaseHex ParseErrorReason = "uppercase hex"
+	// ReasonEmptyArgument signals an empty token, rejected in strict mode
+	ReasonEmptyArgument ParseErrorReason = "empty argument"
+	// ReasonTrailingSeparator signals that the data string ends with a separator, rejected in strict mode
+	ReasonTrailingSeparator ParseErrorReason = "trailing separator"
+	// ReasonMissingArgument signals that the data string holds fewer tokens than required
+	ReasonMissingArgument ParseErrorReason = "missing argument"
+	// ReasonUnpairedToken signals that the last token of a key-value data string has no pair
+	ReasonUnpairedToken ParseErrorReason = "unpaired token"
+	// ReasonInvalidVMTypeLength signals that the VM type does not have the expected length
+	ReasonInvalidVMTypeLength ParseErrorReason = "invalid vm type length"
+	// ReasonInvalidCodeMetadataLength signals that the code metadata does not have the expected length
+	ReasonInvalidCodeMetadataLength ParseErrorReason = "invalid code metadata length"
+	// ReasonUnknownCodeMetadataBits signals that the code metadata holds unknown flags
+	ReasonUnknownCodeMetadataBits ParseErrorReason = "unknown code metadata bits"
+	// ReasonTruncatedLengthPrefix signals that the binary data string ends in the middle of a length prefix
+	ReasonTruncatedLengthPrefix ParseErrorReason = "truncated length prefix"
+	// ReasonTruncatedField signals that the binary data string is shorter than the length prefix of its last field
+	ReasonTruncatedField ParseErrorReason = "truncated field"
+	// ReasonInvalidStorageUpdateFlags signals that the flags of a storage update are not a single byte of known flags
+	ReasonInvalidStorageUpdateFlags ParseErrorReason = "invalid storage update flags"
+)
+
+// ParseError is the error returned by the data parsers, pointing to the malformed token.
+// Index is the position of the token in the data string, the first token (function, code or key) having index 0,
+// while Offset is the position of its first character. Err is the error of the failed parsing step.
+// The default parsers keep the description of Err as the error message, only the strict ones describe the position.
+type ParseError struct {
+	Index    int
+	Offset   int
+	Token    string
+	Reason   ParseErrorReason
+	Err      error
+	isStrict bool
+}
+
+// Error returns the error description
+func (pe *ParseError) Error() string {
+	if !pe.isStrict {
+		return pe.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s at argument %d (offset %d): %q", pe.Err, pe.Reason, pe.Index, pe.Offset, pe.Token)
+}
+
+// Unwrap returns the error of the failed parsing step, so errors.Is can be used against the parsers errors
+func (pe *ParseError) Unwrap() error {
+	return pe.Err
+}
+
+func newParseError(codec CallDataCodec, tokens []string, index int, reason ParseErrorReason, err error) *ParseError {
+	parseError := &ParseError{
+		Index:    index,
+		Offset:   codec.FieldOffset(tokens, index),
+		Reason:   reason,
+		Err:      err,
+		isStrict: codec.IsStrict(),
+	}
+	if index < len(tokens) {
+		parseError.Token = tokens[index]
+	}
+
+	return parseError
+}
@@ -0,0 +1,95 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requireParseError(t *testing.T, err error, expectedErr error, expectedIndex int, expectedReason ParseErrorReason) {
+	require.True(t, errors.Is(err, expectedErr), "expected %v, got %v", expectedErr, err)
+
+	parseError := &ParseError{}
+	require.True(t, errors.As(err, &parseError))
+	require.Equal(t, expectedIndex, parseError.Index)
+	require.Equal(t, expectedReason, parseError.Reason)
+}
+
+func TestParseError_Error(t *testing.T) {
+	t.Parallel()
+
+	parser := NewCallArgsParser()
+	_, _, err := parser.ParseData("foo@aa@0g")
+	requireParseError(t, err, ErrTokenizeFailed, 2, ReasonInvalidHexCharacter)
+
+	parseError := err.(*ParseError)
+	assert.Equal(t, 7, parseError.Offset)
+	assert.Equal(t, "0g", parseError.Token)
+	assert.Equal(t, ErrTokenizeFailed.Error(), err.Error())
+
+	_, _, err = NewStrictCallArgsParser().ParseData("foo@aa@0g")
+	requireParseError(t, err, ErrTokenizeFailed, 2, ReasonInvalidHexCharacter)
+	assert.Equal(t, `tokenize failed: invalid hex character at argument 2 (offset 7): "0g"`, err.Error())
+}
+
+func TestStrictParsers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("call args parser", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewStrictCallArgsParser()
+		function, arguments, err := parser.ParseData("foo@0a0b@01")
+		require.Nil(t, err)
+		require.Equal(t, "foo", function)
+		require.Equal(t, [][]byte{{10, 11}, {1}}, arguments)
+
+		_, _, err = parser.ParseData("foo@0A0B")
+		requireParseError(t, err, ErrTokenizeFailed, 1, ReasonUppercaseHex)
+
+		_, _, err = parser.ParseData("foo@@01")
+		requireParseError(t, err, ErrTokenizeFailed, 1, ReasonEmptyArgument)
+
+		_, _, err = parser.ParseData("foo@01@")
+		requireParseError(t, err, ErrTokenizeFailed, 2, ReasonTrailingSeparator)
+
+		_, err = parser.ParseArguments("foo@01@")
+		requireParseError(t, err, ErrTokenizeFailed, 2, ReasonTrailingSeparator)
+
+		_, _, err = NewCallArgsParser().ParseData("foo@0A0B@@")
+		require.Nil(t, err)
+	})
+	t.Run("deploy args parser", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewStrictDeployArgsParser()
+		parsed, err := parser.ParseData("abba@0123@0100@64")
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{{100}}, parsed.Arguments)
+
+		_, err = parser.ParseData("ABBA@0123@0100")
+		requireParseError(t, err, ErrInvalidCode, 0, ReasonUppercaseHex)
+
+		_, err = parser.ParseData("abba@0123@@64")
+		requireParseError(t, err, ErrTokenizeFailed, 2, ReasonEmptyArgument)
+
+		_, err = parser.ParseData("abba@0123@0100@")
+		requireParseError(t, err, ErrTokenizeFailed, 3, ReasonTrailingSeparator)
+	})
+	t.Run("storage updates parser", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewStrictStorageUpdatesParser()
+		updates, err := parser.GetStorageUpdates("@01@02")
+		require.Nil(t, err)
+		require.Equal(t, 1, len(updates))
+
+		_, err = parser.GetStorageUpdates("01@0A")
+		requireParseError(t, err, ErrTokenizeFailed, 1, ReasonUppercaseHex)
+
+		_, err = parser.GetStorageUpdates("01@")
+		requireParseError(t, err, ErrTokenizeFailed, 1, ReasonTrailingSeparator)
+	})
+}
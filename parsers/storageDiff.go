@@ -0,0 +1,114 @@
+package parsers
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// StorageKeyDiff holds the value of a storage key before and after the execution. An empty After value means that
+// the key is deleted.
+type StorageKeyDiff struct {
+	Key     []byte
+	Before  []byte
+	After   []byte
+	Written bool
+}
+
+// IsDeletion returns true if the key existed before the execution and is deleted by it
+func (diff *StorageKeyDiff) IsDeletion() bool {
+	return len(diff.Before) > 0 && len(diff.After) == 0
+}
+
+// IsChanged returns true if the value of the key is changed by the execution
+func (diff *StorageKeyDiff) IsChanged() bool {
+	return !bytes.Equal(diff.Before, diff.After)
+}
+
+// StorageDiff holds the storage changes of an output account, sorted by key
+type StorageDiff struct {
+	Address []byte
+	Keys    []*StorageKeyDiff
+}
+
+// CreateStorageDiff returns the before and after values of all the storage updates of the output account, the before
+// values being read from the provided storage reader
+func CreateStorageDiff(outputAccount *vmcommon.OutputAccount, reader StorageReader) (*StorageDiff, error) {
+	if outputAccount == nil {
+		return nil, ErrNilOutputAccount
+	}
+	if check.IfNil(reader) {
+		return nil, ErrNilStorageReader
+	}
+
+	diff := &StorageDiff{
+		Address: outputAccount.Address,
+		Keys:    make([]*StorageKeyDiff, 0, len(outputAccount.StorageUpdates)),
+	}
+	for _, storageUpdate := range outputAccount.StorageUpdates {
+		before, _, err := reader.GetStorageData(outputAccount.Address, storageUpdate.Offset)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading key %x", err, storageUpdate.Offset)
+		}
+
+		diff.Keys = append(diff.Keys, &StorageKeyDiff{
+			Key:     storageUpdate.Offset,
+			Before:  before,
+			After:   storageUpdate.Data,
+			Written: storageUpdate.Written,
+		})
+	}
+
+	sort.Slice(diff.Keys, func(i, j int) bool {
+		return bytes.Compare(diff.Keys[i].Key, diff.Keys[j].Key) < 0
+	})
+
+	return diff, nil
+}
+
+// VerifyStorageDiff checks that the before values of the diff match the current values of the storage reader,
+// so the diff can be replayed
+func VerifyStorageDiff(diff *StorageDiff, reader StorageReader) error {
+	if diff == nil {
+		return ErrNilStorageDiff
+	}
+	if check.IfNil(reader) {
+		return ErrNilStorageReader
+	}
+
+	for _, keyDiff := range diff.Keys {
+		current, _, err := reader.GetStorageData(diff.Address, keyDiff.Key)
+		if err != nil {
+			return fmt.Errorf("%w while reading key %x", err, keyDiff.Key)
+		}
+		if !bytes.Equal(current, keyDiff.Before) {
+			return fmt.Errorf("%w for key %x: expected %x, current %x", ErrStorageDiffMismatch, keyDiff.Key, keyDiff.Before, current)
+		}
+	}
+
+	return nil
+}
+
+// CreateOutputAccountFromStorageDiff returns the output account holding the storage updates which replay the diff
+func CreateOutputAccountFromStorageDiff(diff *StorageDiff) (*vmcommon.OutputAccount, error) {
+	if diff == nil {
+		return nil, ErrNilStorageDiff
+	}
+
+	outputAccount := &vmcommon.OutputAccount{
+		Address:        diff.Address,
+		StorageUpdates: make(map[string]*vmcommon.StorageUpdate, len(diff.Keys)),
+	}
+	for _, keyDiff := range diff.Keys {
+		outputAccount.StorageUpdates[string(keyDiff.Key)] = &vmcommon.StorageUpdate{
+			Offset:  keyDiff.Key,
+			Data:    keyDiff.After,
+			Written: keyDiff.Written,
+		}
+	}
+
+	return outputAccount, nil
+}
@@ -0,0 +1,144 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+type storageReaderStub struct {
+	GetStorageDataCalled func(accountAddress []byte, index []byte) ([]byte, uint32, error)
+}
+
+func (stub *storageReaderStub) GetStorageData(accountAddress []byte, index []byte) ([]byte, uint32, error) {
+	if stub.GetStorageDataCalled != nil {
+		return stub.GetStorageDataCalled(accountAddress, index)
+	}
+
+	return nil, 0, nil
+}
+
+func (stub *storageReaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createStorageReader(address []byte, storage map[string][]byte) *storageReaderStub {
+	return &storageReaderStub{
+		GetStorageDataCalled: func(accountAddress []byte, index []byte) ([]byte, uint32, error) {
+			if string(accountAddress) != string(address) {
+				return nil, 0, nil
+			}
+
+			return storage[string(index)], 0, nil
+		},
+	}
+}
+
+func createOutputAccountWithStorageUpdates(address []byte) *vmcommon.OutputAccount {
+	return &vmcommon.OutputAccount{
+		Address: address,
+		StorageUpdates: map[string]*vmcommon.StorageUpdate{
+			"c": {Offset: []byte("c"), Data: []byte("new"), Written: true},
+			"a": {Offset: []byte("a"), Data: nil, Written: true},
+			"b": {Offset: []byte("b"), Data: []byte("same"), Written: false},
+		},
+	}
+}
+
+func TestCreateStorageDiff(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	reader := createStorageReader(address, map[string][]byte{
+		"a": []byte("old"),
+		"b": []byte("same"),
+	})
+
+	t.Run("nil output account should error", func(t *testing.T) {
+		t.Parallel()
+
+		diff, err := CreateStorageDiff(nil, reader)
+		require.Nil(t, diff)
+		require.Equal(t, ErrNilOutputAccount, err)
+	})
+	t.Run("nil storage reader should error", func(t *testing.T) {
+		t.Parallel()
+
+		diff, err := CreateStorageDiff(createOutputAccountWithStorageUpdates(address), nil)
+		require.Nil(t, diff)
+		require.Equal(t, ErrNilStorageReader, err)
+	})
+	t.Run("storage reader error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		failingReader := &storageReaderStub{
+			GetStorageDataCalled: func(_ []byte, _ []byte) ([]byte, uint32, error) {
+				return nil, 0, expectedErr
+			},
+		}
+
+		diff, err := CreateStorageDiff(createOutputAccountWithStorageUpdates(address), failingReader)
+		require.Nil(t, diff)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		diff, err := CreateStorageDiff(createOutputAccountWithStorageUpdates(address), reader)
+		require.Nil(t, err)
+		require.Equal(t, address, diff.Address)
+		require.Equal(t, []*StorageKeyDiff{
+			{Key: []byte("a"), Before: []byte("old"), After: nil, Written: true},
+			{Key: []byte("b"), Before: []byte("same"), After: []byte("same"), Written: false},
+			{Key: []byte("c"), Before: nil, After: []byte("new"), Written: true},
+		}, diff.Keys)
+
+		require.True(t, diff.Keys[0].IsDeletion())
+		require.True(t, diff.Keys[0].IsChanged())
+		require.False(t, diff.Keys[1].IsDeletion())
+		require.False(t, diff.Keys[1].IsChanged())
+		require.False(t, diff.Keys[2].IsDeletion())
+		require.True(t, diff.Keys[2].IsChanged())
+	})
+}
+
+func TestVerifyStorageDiff(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	storage := map[string][]byte{
+		"a": []byte("old"),
+		"b": []byte("same"),
+	}
+	diff, _ := CreateStorageDiff(createOutputAccountWithStorageUpdates(address), createStorageReader(address, storage))
+
+	require.Equal(t, ErrNilStorageDiff, VerifyStorageDiff(nil, createStorageReader(address, storage)))
+	require.Equal(t, ErrNilStorageReader, VerifyStorageDiff(diff, nil))
+	require.Nil(t, VerifyStorageDiff(diff, createStorageReader(address, storage)))
+
+	changedStorage := map[string][]byte{
+		"a": []byte("changed"),
+		"b": []byte("same"),
+	}
+	err := VerifyStorageDiff(diff, createStorageReader(address, changedStorage))
+	require.True(t, errors.Is(err, ErrStorageDiffMismatch))
+}
+
+func TestCreateOutputAccountFromStorageDiff(t *testing.T) {
+	t.Parallel()
+
+	outputAccount, err := CreateOutputAccountFromStorageDiff(nil)
+	require.Nil(t, outputAccount)
+	require.Equal(t, ErrNilStorageDiff, err)
+
+	address := []byte("address")
+	expectedOutputAccount := createOutputAccountWithStorageUpdates(address)
+	diff, _ := CreateStorageDiff(expectedOutputAccount, createStorageReader(address, nil))
+
+	outputAccount, err = CreateOutputAccountFromStorageDiff(diff)
+	require.Nil(t, err)
+	require.Equal(t, expectedOutputAccount, outputAccount)
+}
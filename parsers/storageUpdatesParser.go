@@ -1,40 +1,77 @@
 package parsers
 
 import (
-	"encoding/hex"
-
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-vm-common"
 )
 
+const (
+	// StorageUpdateWrittenFlag marks a storage update which needs to be persisted
+	StorageUpdateWrittenFlag byte = 1
+	// StorageUpdateDeletedFlag marks a storage update deleting the key, encoded without a data field
+	StorageUpdateDeletedFlag byte = 2
+
+	knownStorageUpdateFlags = StorageUpdateWrittenFlag | StorageUpdateDeletedFlag
+)
+
 type storageUpdatesParser struct {
+	codec CallDataCodec
 }
 
 // NewStorageUpdatesParser creates a new parser
 func NewStorageUpdatesParser() *storageUpdatesParser {
-	return &storageUpdatesParser{}
+	return &storageUpdatesParser{
+		codec: NewHexCallDataCodec(),
+	}
+}
+
+// NewStrictStorageUpdatesParser creates a new parser which also rejects uppercase hex, empty tokens and trailing separators
+func NewStrictStorageUpdatesParser() *storageUpdatesParser {
+	return &storageUpdatesParser{
+		codec: NewStrictHexCallDataCodec(),
+	}
 }
 
-// GetStorageUpdates parse data into storage updates
+// NewStorageUpdatesParserWithCodec creates a new parser of the data strings written in the wire format of the provided codec
+func NewStorageUpdatesParserWithCodec(codec CallDataCodec) (*storageUpdatesParser, error) {
+	if check.IfNil(codec) {
+		return nil, ErrNilCallDataCodec
+	}
+
+	return &storageUpdatesParser{
+		codec: codec,
+	}, nil
+}
+
+// GetStorageUpdates parse data into storage updates. The data may start with a separator.
 func (parser *storageUpdatesParser) GetStorageUpdates(data string) ([]*vmcommon.StorageUpdate, error) {
-	data = trimLeadingSeparatorChar(data)
+	tokens, err := parser.codec.SplitFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens = trimLeadingEmptyField(tokens)
+	if len(tokens) == 0 || len(tokens[0]) == 0 {
+		return nil, newParseError(parser.codec, tokens, 0, ReasonEmptyKey, ErrTokenizeFailed)
+	}
 
-	tokens, err := tokenize(data)
+	err = parser.codec.ValidateFields(tokens, 0)
 	if err != nil {
 		return nil, err
 	}
-	err = requireNumTokensIsEven(tokens)
+	err = requireNumFieldsIsEven(parser.codec, tokens)
 	if err != nil {
 		return nil, err
 	}
 
 	storageUpdates := make([]*vmcommon.StorageUpdate, 0, len(tokens))
 	for i := 0; i < len(tokens); i += 2 {
-		offset, err := decodeToken(tokens[i])
+		offset, err := parser.codec.DecodeField(tokens, i)
 		if err != nil {
 			return nil, err
 		}
 
-		value, err := decodeToken(tokens[i+1])
+		value, err := parser.codec.DecodeField(tokens, i+1)
 		if err != nil {
 			return nil, err
 		}
@@ -48,18 +85,105 @@ func (parser *storageUpdatesParser) GetStorageUpdates(data string) ([]*vmcommon.
 
 // CreateDataFromStorageUpdate creates storage update from data
 func (parser *storageUpdatesParser) CreateDataFromStorageUpdate(storageUpdates []*vmcommon.StorageUpdate) string {
-	data := ""
-	for i := 0; i < len(storageUpdates); i++ {
-		storageUpdate := storageUpdates[i]
-		data = data + hex.EncodeToString(storageUpdate.Offset)
-		data = data + atSeparator
-		data = data + hex.EncodeToString(storageUpdate.Data)
-
-		if i < len(storageUpdates)-1 {
-			data = data + atSeparator
+	fields := make([]string, 0, 2*len(storageUpdates))
+	for _, storageUpdate := range storageUpdates {
+		fields = append(fields, parser.codec.EncodeField(storageUpdate.Offset), parser.codec.EncodeField(storageUpdate.Data))
+	}
+
+	return parser.codec.JoinFields(fields)
+}
+
+// GetStorageUpdatesWithFlags parses the data created by CreateDataFromStorageUpdatesWithFlags. Every storage update is
+// encoded as key@flags@data, the data field being missing for the deleted keys.
+func (parser *storageUpdatesParser) GetStorageUpdatesWithFlags(data string) ([]*vmcommon.StorageUpdate, error) {
+	tokens, err := splitFields(parser.codec, data, ReasonEmptyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.codec.ValidateFields(tokens, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	storageUpdates := make([]*vmcommon.StorageUpdate, 0)
+	for i := 0; i < len(tokens); {
+		storageUpdate, numTokens, err := parser.parseStorageUpdateWithFlags(tokens, i)
+		if err != nil {
+			return nil, err
 		}
+
+		storageUpdates = append(storageUpdates, storageUpdate)
+		i += numTokens
+	}
+
+	return storageUpdates, nil
+}
+
+func (parser *storageUpdatesParser) parseStorageUpdateWithFlags(tokens []string, index int) (*vmcommon.StorageUpdate, int, error) {
+	if index+1 >= len(tokens) {
+		return nil, 0, newParseError(parser.codec, tokens, index, ReasonUnpairedToken, ErrInvalidDataString)
+	}
+
+	offset, err := parser.codec.DecodeField(tokens, index)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(offset) == 0 {
+		return nil, 0, newParseError(parser.codec, tokens, index, ReasonEmptyKey, ErrInvalidDataString)
+	}
+
+	flags, err := parser.codec.DecodeField(tokens, index+1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(flags) != 1 || flags[0]&^knownStorageUpdateFlags != 0 {
+		return nil, 0, newParseError(parser.codec, tokens, index+1, ReasonInvalidStorageUpdateFlags, ErrInvalidDataString)
+	}
+
+	storageUpdate := &vmcommon.StorageUpdate{
+		Offset:  offset,
+		Data:    make([]byte, 0),
+		Written: flags[0]&StorageUpdateWrittenFlag != 0,
 	}
-	return data
+	if flags[0]&StorageUpdateDeletedFlag != 0 {
+		return storageUpdate, 2, nil
+	}
+
+	if index+2 >= len(tokens) {
+		return nil, 0, newParseError(parser.codec, tokens, index+2, ReasonMissingArgument, ErrInvalidDataString)
+	}
+	storageUpdate.Data, err = parser.codec.DecodeField(tokens, index+2)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(storageUpdate.Data) == 0 {
+		return nil, 0, newParseError(parser.codec, tokens, index+2, ReasonEmptyArgument, ErrInvalidDataString)
+	}
+
+	return storageUpdate, 3, nil
+}
+
+// CreateDataFromStorageUpdatesWithFlags creates the data holding the storage updates along with their Written flag.
+// The updates with empty data are encoded as deletions, without a data field.
+func (parser *storageUpdatesParser) CreateDataFromStorageUpdatesWithFlags(storageUpdates []*vmcommon.StorageUpdate) string {
+	fields := make([]string, 0, 3*len(storageUpdates))
+	for _, storageUpdate := range storageUpdates {
+		flags := byte(0)
+		if storageUpdate.Written {
+			flags |= StorageUpdateWrittenFlag
+		}
+		if len(storageUpdate.Data) == 0 {
+			flags |= StorageUpdateDeletedFlag
+		}
+
+		fields = append(fields, parser.codec.EncodeField(storageUpdate.Offset), parser.codec.EncodeField([]byte{flags}))
+		if len(storageUpdate.Data) > 0 {
+			fields = append(fields, parser.codec.EncodeField(storageUpdate.Data))
+		}
+	}
+
+	return parser.codec.JoinFields(fields)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
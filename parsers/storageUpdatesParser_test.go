@@ -49,7 +49,7 @@ func TestStorageUpdatesParser_GetStorageUpdatesEmptyData(t *testing.T) {
 	stUpdates, err := parser.GetStorageUpdates("")
 
 	require.Nil(t, stUpdates)
-	require.Equal(t, ErrTokenizeFailed, err)
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyKey)
 }
 
 func TestStorageUpdatesParser_GetStorageUpdatesWrongData(t *testing.T) {
@@ -74,7 +74,7 @@ func TestStorageUpdatesParser_GetStorageUpdatesWrongData(t *testing.T) {
 	stUpdates, err := parser.GetStorageUpdates(result)
 
 	require.Nil(t, stUpdates)
-	require.Equal(t, ErrInvalidDataString, err)
+	requireParseError(t, err, ErrInvalidDataString, 4, ReasonUnpairedToken)
 }
 
 func TestStorageUpdatesParser_GetStorageUpdates(t *testing.T) {
@@ -105,3 +105,72 @@ func TestStorageUpdatesParser_GetStorageUpdates(t *testing.T) {
 		require.Equal(t, test, hex.EncodeToString(stUpdates[i].Offset))
 	}
 }
+
+func TestStorageUpdatesParser_StorageUpdatesWithFlags(t *testing.T) {
+	t.Parallel()
+
+	storageUpdates := []*vmcommon.StorageUpdate{
+		{Offset: []byte{0xAA}, Data: []byte{0x01, 0x02}, Written: true},
+		{Offset: []byte{0xBB}, Data: nil, Written: true},
+		{Offset: []byte{0xCC}, Data: []byte{0x03}, Written: false},
+		{Offset: []byte{0xDD}, Data: []byte{}, Written: false},
+	}
+	expectedStorageUpdates := []*vmcommon.StorageUpdate{
+		{Offset: []byte{0xAA}, Data: []byte{0x01, 0x02}, Written: true},
+		{Offset: []byte{0xBB}, Data: []byte{}, Written: true},
+		{Offset: []byte{0xCC}, Data: []byte{0x03}, Written: false},
+		{Offset: []byte{0xDD}, Data: []byte{}, Written: false},
+	}
+
+	t.Run("hex codec", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewStrictStorageUpdatesParser()
+		data := parser.CreateDataFromStorageUpdatesWithFlags(storageUpdates)
+		require.Equal(t, "aa@01@0102@bb@03@cc@00@03@dd@02", data)
+
+		parsed, err := parser.GetStorageUpdatesWithFlags(data)
+		require.Nil(t, err)
+		require.Equal(t, expectedStorageUpdates, parsed)
+	})
+	t.Run("binary codec", func(t *testing.T) {
+		t.Parallel()
+
+		parser, _ := NewStorageUpdatesParserWithCodec(NewBinaryCallDataCodec())
+		data := parser.CreateDataFromStorageUpdatesWithFlags(storageUpdates)
+
+		parsed, err := parser.GetStorageUpdatesWithFlags(data)
+		require.Nil(t, err)
+		require.Equal(t, expectedStorageUpdates, parsed)
+	})
+}
+
+func TestStorageUpdatesParser_GetStorageUpdatesWithFlagsErrors(t *testing.T) {
+	t.Parallel()
+
+	parser := NewStorageUpdatesParser()
+
+	_, err := parser.GetStorageUpdatesWithFlags("")
+	requireParseError(t, err, ErrTokenizeFailed, 0, ReasonEmptyKey)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa")
+	requireParseError(t, err, ErrInvalidDataString, 0, ReasonUnpairedToken)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa@01")
+	requireParseError(t, err, ErrInvalidDataString, 2, ReasonMissingArgument)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa@01@")
+	requireParseError(t, err, ErrInvalidDataString, 2, ReasonEmptyArgument)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa@04@01")
+	requireParseError(t, err, ErrInvalidDataString, 1, ReasonInvalidStorageUpdateFlags)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa@0101@01")
+	requireParseError(t, err, ErrInvalidDataString, 1, ReasonInvalidStorageUpdateFlags)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa@02@@03")
+	requireParseError(t, err, ErrInvalidDataString, 2, ReasonEmptyKey)
+
+	_, err = parser.GetStorageUpdatesWithFlags("aa@03@bb")
+	requireParseError(t, err, ErrInvalidDataString, 2, ReasonUnpairedToken)
+}
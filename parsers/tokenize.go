@@ -1,41 +1,43 @@
 package parsers
 
-import (
-	"encoding/hex"
-	"strings"
-)
-
-func tokenize(data string) ([]string, error) {
-	tokens := strings.Split(data, atSeparator)
+// splitFields splits the data into fields, requiring a non-empty first field
+func splitFields(codec CallDataCodec, data string, emptyFirstFieldReason ParseErrorReason) ([]string, error) {
+	fields, err := codec.SplitFields(data)
+	if err != nil {
+		return nil, err
+	}
 
-	if len(tokens) == 0 || len(tokens[0]) == 0 {
-		return nil, ErrTokenizeFailed
+	if len(fields) == 0 || len(fields[0]) == 0 {
+		return nil, newParseError(codec, fields, 0, emptyFirstFieldReason, ErrTokenizeFailed)
 	}
 
-	return tokens, nil
+	return fields, nil
 }
 
-func decodeToken(token string) ([]byte, error) {
-	decoded, err := hex.DecodeString(token)
-	if err != nil {
-		return nil, ErrTokenizeFailed
+// withError replaces the error of the failed parsing step, keeping the position and the reason
+func withError(err error, stepErr error) error {
+	parseError, ok := err.(*ParseError)
+	if !ok {
+		return stepErr
 	}
 
-	return decoded, nil
+	parseError.Err = stepErr
+	return parseError
 }
 
-func trimLeadingSeparatorChar(data string) string {
-	if len(data) > 0 && data[0] == atSeparatorChar {
-		data = data[1:]
+// trimLeadingEmptyField drops the empty field produced by a data string starting with a separator
+func trimLeadingEmptyField(fields []string) []string {
+	if len(fields) > 1 && len(fields[0]) == 0 {
+		return fields[1:]
 	}
 
-	return data
+	return fields
 }
 
-func requireNumTokensIsEven(tokens []string) error {
-	if len(tokens)%2 == 0 {
+func requireNumFieldsIsEven(codec CallDataCodec, fields []string) error {
+	if len(fields)%2 == 0 {
 		return nil
 	}
 
-	return ErrInvalidDataString
+	return newParseError(codec, fields, len(fields)-1, ReasonUnpairedToken, ErrInvalidDataString)
 }
@@ -0,0 +1,104 @@
+package scenarios
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// flagsHandler is an enable epochs handler which considers all the flags enabled, except the disabled ones
+type flagsHandler struct {
+	disabledFlags map[core.EnableEpochFlag]struct{}
+}
+
+func newFlagsHandler(disabledFlags []string) *flagsHandler {
+	handler := &flagsHandler{
+		disabledFlags: make(map[core.EnableEpochFlag]struct{}),
+	}
+	for _, flag := range disabledFlags {
+		handler.disabledFlags[core.EnableEpochFlag(flag)] = struct{}{}
+	}
+
+	return handler
+}
+
+// IsFlagDefined returns true as all flags are defined
+func (handler *flagsHandler) IsFlagDefined(_ core.EnableEpochFlag) bool {
+	return true
+}
+
+// IsFlagEnabled returns true if the flag was not disabled
+func (handler *flagsHandler) IsFlagEnabled(flag core.EnableEpochFlag) bool {
+	_, isDisabled := handler.disabledFlags[flag]
+	return !isDisabled
+}
+
+// IsFlagEnabledInEpoch returns true if the flag was not disabled, regardless of the epoch
+func (handler *flagsHandler) IsFlagEnabledInEpoch(flag core.EnableEpochFlag, _ uint32) bool {
+	return handler.IsFlagEnabled(flag)
+}
+
+// GetActivationEpoch returns 0 as all the enabled flags are active from genesis
+func (handler *flagsHandler) GetActivationEpoch(_ core.EnableEpochFlag) uint32 {
+	return 0
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (handler *flagsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
+
+// disabledGuardedAccountHandler is a guarded account handler for which no account has an active guardian
+type disabledGuardedAccountHandler struct {
+}
+
+// GetActiveGuardian returns nil as there are no guardians
+func (handler *disabledGuardedAccountHandler) GetActiveGuardian(_ vmcommon.UserAccountHandler) ([]byte, error) {
+	return nil, nil
+}
+
+// SetGuardian does nothing as this is a disabled handler
+func (handler *disabledGuardedAccountHandler) SetGuardian(_ vmcommon.UserAccountHandler, _ []byte, _ []byte, _ []byte) error {
+	return nil
+}
+
+// CleanOtherThanActive does nothing as this is a disabled handler
+func (handler *disabledGuardedAccountHandler) CleanOtherThanActive(_ vmcommon.UserAccountHandler) {
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (handler *disabledGuardedAccountHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
+
+// payableHandler decides if an account is payable based on the code metadata of the receiver
+type payableHandler struct {
+	accounts vmcommon.AccountsAdapter
+}
+
+// IsPayable returns true if the receiver is a user account or a smart contract with the payable code metadata flag set
+func (handler *payableHandler) IsPayable(sndAddress, rcvAddress []byte) (bool, error) {
+	if !vmcommon.IsSmartContractAddress(rcvAddress) {
+		return true, nil
+	}
+
+	account, err := handler.accounts.GetExistingAccount(rcvAddress)
+	if err != nil {
+		return false, nil
+	}
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return false, nil
+	}
+
+	metadata := vmcommon.CodeMetadataFromBytes(userAccount.GetCodeMetadata())
+	if metadata.Payable {
+		return true, nil
+	}
+
+	return metadata.PayableBySC && vmcommon.IsSmartContractAddress(sndAddress), nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (handler *payableHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,110 @@
+package scenarios
+
+// Scenario is a declarative test for the built-in functions. It holds the accounts state before the execution,
+// the list of built-in function calls together with their expected output and the expected state after all steps
+// were executed. The scenario runs in a single shard, unless NumShards is set, SelfShardID being the shard of the
+// executing node.
+type Scenario struct {
+	Name          string                       `json:"name"`
+	NumShards     uint32                       `json:"numShards"`
+	SelfShardID   uint32                       `json:"selfShardID"`
+	DisabledFlags []string                     `json:"disabledFlags"`
+	GasSchedule   map[string]map[string]uint64 `json:"gasSchedule"`
+	PreState      *State                       `json:"preState"`
+	Steps         []*Step                      `json:"steps"`
+	PostState     *State                       `json:"postState"`
+}
+
+// State holds the accounts and the tokens global settings. In the post-state only the listed accounts, storage keys,
+// tokens and global settings are checked.
+type State struct {
+	Accounts       map[string]*Account        `json:"accounts"`
+	GlobalSettings map[string]*GlobalSettings `json:"globalSettings"`
+}
+
+// Account holds the state of one account. Empty fields are not checked in the post-state.
+type Account struct {
+	Nonce        string                `json:"nonce"`
+	Balance      string                `json:"balance"`
+	Owner        string                `json:"owner"`
+	CodeMetadata string                `json:"codeMetadata"`
+	Username     string                `json:"username"`
+	Storage      map[string]string     `json:"storage"`
+	DCDT         map[string]*TokenData `json:"dcdt"`
+}
+
+// TokenData holds the instances of one token and the roles the account has for it
+type TokenData struct {
+	Instances []*TokenInstance `json:"instances"`
+	Roles     []string         `json:"roles"`
+}
+
+// TokenInstance holds the balance, the user properties and the metadata of one token nonce. The metadata fields
+// are checked in the post-state only if not empty.
+type TokenInstance struct {
+	Nonce      string   `json:"nonce"`
+	Balance    string   `json:"balance"`
+	Type       string   `json:"type"`
+	Frozen     bool     `json:"frozen"`
+	Name       string   `json:"name"`
+	Creator    string   `json:"creator"`
+	Royalties  string   `json:"royalties"`
+	Hash       string   `json:"hash"`
+	URIs       []string `json:"uris"`
+	Attributes string   `json:"attributes"`
+}
+
+// GlobalSettings holds the token settings saved on the system account
+type GlobalSettings struct {
+	Paused          bool   `json:"paused"`
+	LimitedTransfer bool   `json:"limitedTransfer"`
+	BurnRoleForAll  bool   `json:"burnRoleForAll"`
+	TokenType       string `json:"tokenType"`
+}
+
+// Step defines one built-in function call and its expected result
+type Step struct {
+	Name        string    `json:"name"`
+	Caller      string    `json:"caller"`
+	Recipient   string    `json:"recipient"`
+	Function    string    `json:"function"`
+	Arguments   []string  `json:"arguments"`
+	CallValue   string    `json:"callValue"`
+	GasProvided string    `json:"gasProvided"`
+	Expect      *Expected `json:"expect"`
+}
+
+// Expected holds the expected output of a step. An empty Error means the call must not fail, while nil
+// ReturnData, GasRemaining, Logs or OutputAccounts are not checked. Only the listed output accounts are checked.
+type Expected struct {
+	Error          string                            `json:"error"`
+	ReturnCode     string                            `json:"returnCode"`
+	ReturnMessage  string                            `json:"returnMessage"`
+	ReturnData     []string                          `json:"returnData"`
+	GasRemaining   *string                           `json:"gasRemaining"`
+	Logs           []*ExpectedLog                    `json:"logs"`
+	OutputAccounts map[string]*ExpectedOutputAccount `json:"outputAccounts"`
+}
+
+// ExpectedOutputAccount holds the expected output account of a step, indexed by its address. An empty BalanceDelta
+// or nil OutputTransfers are not checked.
+type ExpectedOutputAccount struct {
+	BalanceDelta    string                    `json:"balanceDelta"`
+	OutputTransfers []*ExpectedOutputTransfer `json:"outputTransfers"`
+}
+
+// ExpectedOutputTransfer holds one expected output transfer. An empty CallType means a direct call.
+type ExpectedOutputTransfer struct {
+	Value    string `json:"value"`
+	Data     string `json:"data"`
+	GasLimit string `json:"gasLimit"`
+	CallType string `json:"callType"`
+}
+
+// ExpectedLog holds one expected log entry. Nil Topics or Data are not checked.
+type ExpectedLog struct {
+	Identifier string   `json:"identifier"`
+	Address    string   `json:"address"`
+	Topics     []string `json:"topics"`
+	Data       []string `json:"data"`
+}
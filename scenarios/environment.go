@@ -0,0 +1,749 @@
+package scenarios
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/builtInFunctions"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/inMemoryAccounts"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/outputChecker"
+)
+
+const (
+	dcdtKeyPrefix     = core.ProtectedKeyPrefix + core.DCDTKeyIdentifier
+	dcdtRoleKeyPrefix = core.ProtectedKeyPrefix + core.DCDTRoleIdentifier + core.DCDTKeyIdentifier
+	defaultGasCost    = uint64(1)
+	maxNumOfAddresses = 100
+)
+
+// environment holds a fresh in-memory accounts state and the built-in functions working on it
+type environment struct {
+	accounts       vmcommon.AccountsAdapter
+	marshaller     vmcommon.Marshalizer
+	globalSettings globalSettingsHandler
+	storage        vmcommon.DCDTNFTStorageHandler
+	executor       builtInFunctionExecutor
+}
+
+func newEnvironment(scenario *Scenario, marshaller vmcommon.Marshalizer, hasher hashing.Hasher) (*environment, error) {
+	accounts, err := inMemoryAccounts.NewAccountsAdapter(hasher)
+	if err != nil {
+		return nil, err
+	}
+	numShards := scenario.NumShards
+	if numShards == 0 {
+		numShards = 1
+	}
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(numShards, scenario.SelfShardID)
+	if err != nil {
+		return nil, err
+	}
+	enableEpochsHandler := newFlagsHandler(scenario.DisabledFlags)
+
+	creator, err := builtInFunctions.NewBuiltInFunctionsCreator(builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:                           createGasSchedule(scenario.GasSchedule),
+		MapDNSAddresses:                  make(map[string]struct{}),
+		MapDNSV2Addresses:                make(map[string]struct{}),
+		Marshalizer:                      marshaller,
+		Accounts:                         accounts,
+		ShardCoordinator:                 shardCoordinator,
+		EnableEpochsHandler:              enableEpochsHandler,
+		GuardedAccountHandler:            &disabledGuardedAccountHandler{},
+		MaxNumOfAddressesForTransferRole: maxNumOfAddresses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = creator.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return nil, err
+	}
+	err = creator.SetPayableHandler(&payableHandler{accounts: accounts})
+	if err != nil {
+		return nil, err
+	}
+
+	globalSettings, err := builtInFunctions.NewDCDTGlobalSettingsFunc(
+		accounts,
+		marshaller,
+		true,
+		core.BuiltInFunctionDCDTPause,
+		func() bool {
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := builtInFunctions.NewDCDTDataStorage(builtInFunctions.ArgsNewDCDTDataStorage{
+		Accounts:              accounts,
+		GlobalSettingsHandler: globalSettings,
+		Marshalizer:           marshaller,
+		EnableEpochsHandler:   enableEpochsHandler,
+		ShardCoordinator:      shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	checker, err := outputChecker.NewOutputChecker(outputChecker.ArgsNewOutputChecker{
+		Marshalizer: marshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+	executor, err := builtInFunctions.NewBuiltInFunctionExecutor(builtInFunctions.ArgsNewBuiltInFunctionExecutor{
+		Accounts:               accounts,
+		ShardCoordinator:       shardCoordinator,
+		BuiltInFunctionFactory: creator,
+		OutputChecker:          checker,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &environment{
+		accounts:       accounts,
+		marshaller:     marshaller,
+		globalSettings: globalSettings,
+		storage:        storage,
+		executor:       executor,
+	}, nil
+}
+
+// createGasSchedule returns a gas schedule with all the costs set to the default value, overwritten by the provided costs
+func createGasSchedule(costs map[string]map[string]uint64) map[string]map[string]uint64 {
+	gasSchedule := map[string]map[string]uint64{
+		core.BaseOperationCostString: createDefaultGasCosts(vmcommon.BaseOperationCost{}),
+		core.BuiltInCostString:       createDefaultGasCosts(vmcommon.BuiltInCost{}),
+	}
+	for section, sectionCosts := range costs {
+		if gasSchedule[section] == nil {
+			gasSchedule[section] = make(map[string]uint64)
+		}
+		for name, cost := range sectionCosts {
+			gasSchedule[section][name] = cost
+		}
+	}
+
+	return gasSchedule
+}
+
+func createDefaultGasCosts(costsStruct interface{}) map[string]uint64 {
+	costs := make(map[string]uint64)
+	structType := reflect.TypeOf(costsStruct)
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Type.Kind() == reflect.Uint64 {
+			costs[field.Name] = defaultGasCost
+		}
+	}
+
+	return costs
+}
+
+func (env *environment) loadUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	account, err := env.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, builtInFunctions.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func (env *environment) setState(state *State) error {
+	if state == nil {
+		return nil
+	}
+
+	// global settings are set first as the token type is needed when saving the tokens
+	for tokenID, settings := range state.GlobalSettings {
+		err := env.setGlobalSettings(tokenID, settings)
+		if err != nil {
+			return err
+		}
+	}
+
+	for addressValue, account := range state.Accounts {
+		address, err := parseValue(addressValue)
+		if err != nil {
+			return err
+		}
+
+		err = env.setAccount(address, account)
+		if err != nil {
+			return fmt.Errorf("account %s: %w", addressValue, err)
+		}
+	}
+
+	_, err := env.accounts.Commit()
+	return err
+}
+
+func (env *environment) setGlobalSettings(tokenID string, settings *GlobalSettings) error {
+	tokenKey, err := computeTokenKey(tokenID)
+	if err != nil {
+		return err
+	}
+
+	systemAccount, err := env.loadUserAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return err
+	}
+	metadata := &builtInFunctions.DCDTGlobalMetadata{
+		Paused:          settings.Paused,
+		LimitedTransfer: settings.LimitedTransfer,
+		BurnRoleForAll:  settings.BurnRoleForAll,
+	}
+	err = systemAccount.AccountDataHandler().SaveKeyValue(tokenKey, metadata.ToBytes())
+	if err != nil {
+		return err
+	}
+	err = env.accounts.SaveAccount(systemAccount)
+	if err != nil {
+		return err
+	}
+
+	if len(settings.TokenType) == 0 {
+		return nil
+	}
+	tokenType, err := core.ConvertDCDTTypeToUint32(settings.TokenType)
+	if err != nil {
+		return err
+	}
+
+	return env.globalSettings.SetTokenType(tokenKey, tokenType)
+}
+
+func (env *environment) setAccount(address []byte, account *Account) error {
+	userAccount, err := env.loadUserAccount(address)
+	if err != nil {
+		return err
+	}
+
+	err = setAccountFields(userAccount, account)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range account.Storage {
+		err = saveKeyValue(userAccount, key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	for tokenID, tokenData := range account.DCDT {
+		err = env.setRoles(userAccount, tokenID, tokenData.Roles)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = env.accounts.SaveAccount(userAccount)
+	if err != nil {
+		return err
+	}
+
+	for tokenID, tokenData := range account.DCDT {
+		for _, instance := range tokenData.Instances {
+			err = env.setTokenInstance(address, tokenID, instance)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func setAccountFields(userAccount vmcommon.UserAccountHandler, account *Account) error {
+	nonce, err := parseUint64(account.Nonce)
+	if err != nil {
+		return err
+	}
+	userAccount.IncreaseNonce(nonce)
+
+	balance, err := parseBigInt(account.Balance)
+	if err != nil {
+		return err
+	}
+	err = userAccount.AddToBalance(balance)
+	if err != nil {
+		return err
+	}
+
+	owner, err := parseValue(account.Owner)
+	if err != nil {
+		return err
+	}
+	if len(owner) > 0 {
+		userAccount.SetOwnerAddress(owner)
+	}
+
+	codeMetadata, err := parseValue(account.CodeMetadata)
+	if err != nil {
+		return err
+	}
+	if len(codeMetadata) > 0 {
+		userAccount.SetCodeMetadata(codeMetadata)
+	}
+
+	username, err := parseValue(account.Username)
+	if err != nil {
+		return err
+	}
+	if len(username) > 0 {
+		userAccount.SetUserName(username)
+	}
+
+	return nil
+}
+
+func saveKeyValue(userAccount vmcommon.UserAccountHandler, keyValue string, valueValue string) error {
+	key, err := parseValue(keyValue)
+	if err != nil {
+		return err
+	}
+	value, err := parseValue(valueValue)
+	if err != nil {
+		return err
+	}
+
+	return userAccount.AccountDataHandler().SaveKeyValue(key, value)
+}
+
+func (env *environment) setRoles(userAccount vmcommon.UserAccountHandler, tokenID string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	token, err := parseValue(tokenID)
+	if err != nil {
+		return err
+	}
+
+	dcdtRoles := &dcdt.DCDTRoles{}
+	for _, role := range roles {
+		dcdtRoles.Roles = append(dcdtRoles.Roles, []byte(role))
+	}
+	marshaledRoles, err := env.marshaller.Marshal(dcdtRoles)
+	if err != nil {
+		return err
+	}
+
+	return userAccount.AccountDataHandler().SaveKeyValue(append([]byte(dcdtRoleKeyPrefix), token...), marshaledRoles)
+}
+
+func (env *environment) setTokenInstance(address []byte, tokenID string, instance *TokenInstance) error {
+	tokenKey, err := computeTokenKey(tokenID)
+	if err != nil {
+		return err
+	}
+	dcdtData, err := createDCDTData(instance)
+	if err != nil {
+		return err
+	}
+	nonce := uint64(0)
+	if dcdtData.TokenMetaData != nil {
+		nonce = dcdtData.TokenMetaData.Nonce
+	}
+
+	userAccount, err := env.loadUserAccount(address)
+	if err != nil {
+		return err
+	}
+	saveArgs := vmcommon.NftSaveArgs{
+		MustUpdateAllFields:         true,
+		IsReturnWithError:           true,
+		KeepMetaDataOnZeroLiquidity: false,
+	}
+	_, err = env.storage.SaveDCDTNFTToken(address, userAccount, tokenKey, nonce, dcdtData, saveArgs)
+	if err != nil {
+		return err
+	}
+	err = env.accounts.SaveAccount(userAccount)
+	if err != nil {
+		return err
+	}
+
+	return env.storage.AddToLiquiditySystemAcc(tokenKey, dcdtData.Type, nonce, dcdtData.Value, false)
+}
+
+func createDCDTData(instance *TokenInstance) (*dcdt.DCDigitalToken, error) {
+	nonce, err := parseUint64(instance.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	value, err := parseBigInt(instance.Balance)
+	if err != nil {
+		return nil, err
+	}
+	tokenType, err := parseTokenType(instance.Type, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	userMetadata := builtInFunctions.DCDTUserMetadata{Frozen: instance.Frozen}
+	dcdtData := &dcdt.DCDigitalToken{
+		Type:       tokenType,
+		Value:      value,
+		Properties: userMetadata.ToBytes(),
+	}
+	if nonce == 0 {
+		return dcdtData, nil
+	}
+
+	dcdtData.TokenMetaData = &dcdt.MetaData{Nonce: nonce}
+	dcdtData.TokenMetaData.Name, err = parseValue(instance.Name)
+	if err != nil {
+		return nil, err
+	}
+	dcdtData.TokenMetaData.Creator, err = parseValue(instance.Creator)
+	if err != nil {
+		return nil, err
+	}
+	royalties, err := parseUint64(instance.Royalties)
+	if err != nil {
+		return nil, err
+	}
+	dcdtData.TokenMetaData.Royalties = uint32(royalties)
+	dcdtData.TokenMetaData.Hash, err = parseValue(instance.Hash)
+	if err != nil {
+		return nil, err
+	}
+	dcdtData.TokenMetaData.URIs, err = parseValues(instance.URIs)
+	if err != nil {
+		return nil, err
+	}
+	dcdtData.TokenMetaData.Attributes, err = parseValue(instance.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return dcdtData, nil
+}
+
+func parseTokenType(tokenType string, nonce uint64) (uint32, error) {
+	if len(tokenType) > 0 {
+		return core.ConvertDCDTTypeToUint32(tokenType)
+	}
+	if nonce == 0 {
+		return uint32(core.Fungible), nil
+	}
+
+	return uint32(core.NonFungible), nil
+}
+
+func computeTokenKey(tokenID string) ([]byte, error) {
+	token, err := parseValue(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(dcdtKeyPrefix), token...), nil
+}
+
+func (env *environment) checkState(state *State) error {
+	if state == nil {
+		return nil
+	}
+
+	for tokenID, settings := range state.GlobalSettings {
+		err := env.checkGlobalSettings(tokenID, settings)
+		if err != nil {
+			return fmt.Errorf("global settings %s: %w", tokenID, err)
+		}
+	}
+
+	for addressValue, account := range state.Accounts {
+		address, err := parseValue(addressValue)
+		if err != nil {
+			return err
+		}
+
+		err = env.checkAccount(address, account)
+		if err != nil {
+			return fmt.Errorf("account %s: %w", addressValue, err)
+		}
+	}
+
+	return nil
+}
+
+func (env *environment) checkGlobalSettings(tokenID string, expected *GlobalSettings) error {
+	tokenKey, err := computeTokenKey(tokenID)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := env.globalSettings.GetGlobalMetadata(tokenKey)
+	if err != nil {
+		return err
+	}
+	if metadata.Paused != expected.Paused ||
+		metadata.LimitedTransfer != expected.LimitedTransfer ||
+		metadata.BurnRoleForAll != expected.BurnRoleForAll {
+		return fmt.Errorf("%w, expected settings %+v, got %+v", ErrCheckFailed, *expected, *metadata)
+	}
+
+	if len(expected.TokenType) == 0 {
+		return nil
+	}
+	expectedType, err := core.ConvertDCDTTypeToUint32(expected.TokenType)
+	if err != nil {
+		return err
+	}
+	tokenType, err := env.globalSettings.GetTokenType(tokenKey)
+	if err != nil {
+		return err
+	}
+
+	return checkEqual("token type", core.DCDTType(expectedType).String(), core.DCDTType(tokenType).String())
+}
+
+func (env *environment) checkAccount(address []byte, expected *Account) error {
+	userAccount, err := env.loadUserAccount(address)
+	if err != nil {
+		return err
+	}
+
+	err = checkAccountFields(userAccount, expected)
+	if err != nil {
+		return err
+	}
+
+	for keyValue, valueValue := range expected.Storage {
+		key, errParse := parseValue(keyValue)
+		if errParse != nil {
+			return errParse
+		}
+		value, _, _ := userAccount.AccountDataHandler().RetrieveValue(key)
+		err = checkBytes("storage "+keyValue, valueValue, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	for tokenID, tokenData := range expected.DCDT {
+		err = env.checkTokenData(userAccount, tokenID, tokenData)
+		if err != nil {
+			return fmt.Errorf("token %s: %w", tokenID, err)
+		}
+	}
+
+	return nil
+}
+
+func checkAccountFields(userAccount vmcommon.UserAccountHandler, expected *Account) error {
+	if len(expected.Nonce) > 0 {
+		nonce, err := parseUint64(expected.Nonce)
+		if err != nil {
+			return err
+		}
+		err = checkEqual("nonce", nonce, userAccount.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+	if len(expected.Balance) > 0 {
+		err := checkBigInt("balance", expected.Balance, userAccount.GetBalance())
+		if err != nil {
+			return err
+		}
+	}
+	if len(expected.Owner) > 0 {
+		err := checkBytes("owner", expected.Owner, userAccount.GetOwnerAddress())
+		if err != nil {
+			return err
+		}
+	}
+	if len(expected.CodeMetadata) > 0 {
+		err := checkBytes("code metadata", expected.CodeMetadata, userAccount.GetCodeMetadata())
+		if err != nil {
+			return err
+		}
+	}
+	if len(expected.Username) > 0 {
+		return checkBytes("username", expected.Username, userAccount.GetUserName())
+	}
+
+	return nil
+}
+
+func (env *environment) checkTokenData(userAccount vmcommon.UserAccountHandler, tokenID string, expected *TokenData) error {
+	tokenKey, err := computeTokenKey(tokenID)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range expected.Instances {
+		err = env.checkTokenInstance(userAccount, tokenKey, instance)
+		if err != nil {
+			return err
+		}
+	}
+
+	if expected.Roles == nil {
+		return nil
+	}
+
+	return env.checkRoles(userAccount, tokenID, expected.Roles)
+}
+
+func (env *environment) checkTokenInstance(userAccount vmcommon.UserAccountHandler, tokenKey []byte, expected *TokenInstance) error {
+	nonce, err := parseUint64(expected.Nonce)
+	if err != nil {
+		return err
+	}
+
+	dcdtData, _, err := env.storage.GetDCDTNFTTokenOnDestination(userAccount, tokenKey, nonce)
+	if err != nil {
+		return err
+	}
+
+	prefix := fmt.Sprintf("nonce %d ", nonce)
+	err = checkBigInt(prefix+"balance", expected.Balance, dcdtData.Value)
+	if err != nil {
+		return err
+	}
+	userMetadata := builtInFunctions.DCDTUserMetadataFromBytes(dcdtData.Properties)
+	err = checkEqual(prefix+"frozen", expected.Frozen, userMetadata.Frozen)
+	if err != nil {
+		return err
+	}
+	if len(expected.Type) > 0 {
+		expectedType, errConvert := core.ConvertDCDTTypeToUint32(expected.Type)
+		if errConvert != nil {
+			return errConvert
+		}
+		err = checkEqual(prefix+"type", core.DCDTType(expectedType).String(), core.DCDTType(dcdtData.Type).String())
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkMetaData(prefix, dcdtData.TokenMetaData, expected)
+}
+
+func checkMetaData(prefix string, metadata *dcdt.MetaData, expected *TokenInstance) error {
+	if metadata == nil {
+		metadata = &dcdt.MetaData{}
+	}
+
+	fields := []struct {
+		name     string
+		expected string
+		actual   []byte
+	}{
+		{name: "name", expected: expected.Name, actual: metadata.Name},
+		{name: "creator", expected: expected.Creator, actual: metadata.Creator},
+		{name: "royalties", expected: expected.Royalties, actual: big.NewInt(int64(metadata.Royalties)).Bytes()},
+		{name: "hash", expected: expected.Hash, actual: metadata.Hash},
+		{name: "attributes", expected: expected.Attributes, actual: metadata.Attributes},
+	}
+	for _, field := range fields {
+		if len(field.expected) == 0 {
+			continue
+		}
+
+		err := checkBytes(prefix+field.name, field.expected, field.actual)
+		if err != nil {
+			return err
+		}
+	}
+
+	if expected.URIs == nil {
+		return nil
+	}
+
+	return checkBytesList(prefix+"uris", expected.URIs, metadata.URIs)
+}
+
+func (env *environment) checkRoles(userAccount vmcommon.UserAccountHandler, tokenID string, expectedRoles []string) error {
+	token, err := parseValue(tokenID)
+	if err != nil {
+		return err
+	}
+
+	dcdtRoles := &dcdt.DCDTRoles{}
+	marshaledRoles, _, _ := userAccount.AccountDataHandler().RetrieveValue(append([]byte(dcdtRoleKeyPrefix), token...))
+	if len(marshaledRoles) > 0 {
+		err = env.marshaller.Unmarshal(dcdtRoles, marshaledRoles)
+		if err != nil {
+			return err
+		}
+	}
+
+	roles := make([]string, 0, len(dcdtRoles.Roles))
+	for _, role := range dcdtRoles.Roles {
+		roles = append(roles, string(role))
+	}
+	expected := append(make([]string, 0, len(expectedRoles)), expectedRoles...)
+	sort.Strings(roles)
+	sort.Strings(expected)
+
+	return checkEqual("roles", fmt.Sprint(expected), fmt.Sprint(roles))
+}
+
+func checkEqual(name string, expected interface{}, actual interface{}) error {
+	if expected != actual {
+		return fmt.Errorf("%w, %s: expected %v, got %v", ErrCheckFailed, name, expected, actual)
+	}
+
+	return nil
+}
+
+func checkBytes(name string, expectedValue string, actual []byte) error {
+	expected, err := parseValue(expectedValue)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected, actual) {
+		return fmt.Errorf("%w, %s: expected %s (0x%x), got 0x%x", ErrCheckFailed, name, expectedValue, expected, actual)
+	}
+
+	return nil
+}
+
+func checkBigInt(name string, expectedValue string, actual *big.Int) error {
+	expected, err := parseBigInt(expectedValue)
+	if err != nil {
+		return err
+	}
+	if actual == nil {
+		actual = big.NewInt(0)
+	}
+	if expected.Cmp(actual) != 0 {
+		return fmt.Errorf("%w, %s: expected %s, got %s", ErrCheckFailed, name, expected.String(), actual.String())
+	}
+
+	return nil
+}
+
+func checkBytesList(name string, expectedValues []string, actual [][]byte) error {
+	if len(expectedValues) != len(actual) {
+		return fmt.Errorf("%w, %s: expected %d values, got %d", ErrCheckFailed, name, len(expectedValues), len(actual))
+	}
+
+	for i, expectedValue := range expectedValues {
+		err := checkBytes(fmt.Sprintf("%s[%d]", name, i), expectedValue, actual[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package scenarios
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilScenario signals that a nil scenario has been provided
+var ErrNilScenario = errors.New("nil scenario")
+
+// ErrInvalidValue signals that a scenario value could not be parsed
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrUnexpectedError signals that a step failed although no error was expected
+var ErrUnexpectedError = errors.New("unexpected error")
+
+// ErrCheckFailed signals that the actual result differs from the expected one
+var ErrCheckFailed = errors.New("check failed")
@@ -0,0 +1,18 @@
+package scenarios
+
+import (
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/builtInFunctions"
+)
+
+type builtInFunctionExecutor interface {
+	ExecuteBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
+	IsInterfaceNil() bool
+}
+
+type globalSettingsHandler interface {
+	GetGlobalMetadata(dcdtTokenKey []byte) (*builtInFunctions.DCDTGlobalMetadata, error)
+	GetTokenType(dcdtTokenKey []byte) (uint32, error)
+	SetTokenType(dcdtTokenKey []byte, tokenType uint32) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,310 @@
+package scenarios
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// ArgsNewRunner defines the arguments needed to create a new scenario runner
+type ArgsNewRunner struct {
+	Marshalizer vmcommon.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type runner struct {
+	marshaller vmcommon.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewRunner creates a component able to execute scenarios against the built-in functions.
+// Every scenario runs on a fresh in-memory accounts state, in a single shard unless configured otherwise.
+func NewRunner(args ArgsNewRunner) (*runner, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &runner{
+		marshaller: args.Marshalizer,
+		hasher:     args.Hasher,
+	}, nil
+}
+
+// LoadScenario reads and decodes the scenario from the provided json file
+func LoadScenario(path string) (*Scenario, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	scenario := &Scenario{}
+	err = json.Unmarshal(content, scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+// RunFile loads and runs the scenario from the provided json file
+func (r *runner) RunFile(path string) error {
+	scenario, err := LoadScenario(path)
+	if err != nil {
+		return err
+	}
+
+	return r.RunScenario(scenario)
+}
+
+// RunScenario sets the pre-state, executes all the steps checking their output and then checks the post-state
+func (r *runner) RunScenario(scenario *Scenario) error {
+	if scenario == nil {
+		return ErrNilScenario
+	}
+
+	env, err := newEnvironment(scenario, r.marshaller, r.hasher)
+	if err != nil {
+		return err
+	}
+
+	err = env.setState(scenario.PreState)
+	if err != nil {
+		return fmt.Errorf("scenario %s, pre-state: %w", scenario.Name, err)
+	}
+
+	for i, step := range scenario.Steps {
+		err = env.runStep(step)
+		if err != nil {
+			return fmt.Errorf("scenario %s, step %d %s: %w", scenario.Name, i, step.Name, err)
+		}
+	}
+
+	err = env.checkState(scenario.PostState)
+	if err != nil {
+		return fmt.Errorf("scenario %s, post-state: %w", scenario.Name, err)
+	}
+
+	return nil
+}
+
+func (env *environment) runStep(step *Step) error {
+	input, err := createCallInput(step)
+	if err != nil {
+		return err
+	}
+
+	expected := step.Expect
+	if expected == nil {
+		expected = &Expected{}
+	}
+
+	vmOutput, err := env.executor.ExecuteBuiltInFunction(input)
+	if len(expected.Error) > 0 {
+		if err == nil || !strings.Contains(err.Error(), expected.Error) {
+			return fmt.Errorf("%w, expected error %s, got %v", ErrCheckFailed, expected.Error, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUnexpectedError, err.Error())
+	}
+
+	_, err = env.accounts.Commit()
+	if err != nil {
+		return err
+	}
+
+	return checkOutput(vmOutput, expected)
+}
+
+func createCallInput(step *Step) (*vmcommon.ContractCallInput, error) {
+	caller, err := parseValue(step.Caller)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := parseValue(step.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	arguments, err := parseValues(step.Arguments)
+	if err != nil {
+		return nil, err
+	}
+	callValue, err := parseBigInt(step.CallValue)
+	if err != nil {
+		return nil, err
+	}
+	gasProvided, err := parseUint64(step.GasProvided)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   arguments,
+			CallValue:   callValue,
+			CallType:    vm.DirectCall,
+			GasProvided: gasProvided,
+		},
+		RecipientAddr: recipient,
+		Function:      step.Function,
+	}, nil
+}
+
+func checkOutput(vmOutput *vmcommon.VMOutput, expected *Expected) error {
+	expectedReturnCode := expected.ReturnCode
+	if len(expectedReturnCode) == 0 {
+		expectedReturnCode = vmcommon.Ok.String()
+	}
+	err := checkEqual("return code", expectedReturnCode, vmOutput.ReturnCode.String())
+	if err != nil {
+		return err
+	}
+	err = checkEqual("return message", expected.ReturnMessage, vmOutput.ReturnMessage)
+	if err != nil {
+		return err
+	}
+
+	if expected.ReturnData != nil {
+		err = checkBytesList("return data", expected.ReturnData, vmOutput.ReturnData)
+		if err != nil {
+			return err
+		}
+	}
+	if expected.GasRemaining != nil {
+		gasRemaining, errParse := parseUint64(*expected.GasRemaining)
+		if errParse != nil {
+			return errParse
+		}
+		err = checkEqual("gas remaining", gasRemaining, vmOutput.GasRemaining)
+		if err != nil {
+			return err
+		}
+	}
+
+	if expected.Logs != nil {
+		err = checkLogs(expected.Logs, vmOutput.Logs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkOutputAccounts(expected.OutputAccounts, vmOutput.OutputAccounts)
+}
+
+func checkLogs(expectedLogs []*ExpectedLog, logs []*vmcommon.LogEntry) error {
+	err := checkEqual("number of logs", len(expectedLogs), len(logs))
+	if err != nil {
+		return err
+	}
+
+	for i, expectedLog := range expectedLogs {
+		prefix := fmt.Sprintf("log %d ", i)
+		err = checkBytes(prefix+"identifier", expectedLog.Identifier, logs[i].Identifier)
+		if err != nil {
+			return err
+		}
+		err = checkBytes(prefix+"address", expectedLog.Address, logs[i].Address)
+		if err != nil {
+			return err
+		}
+		if expectedLog.Topics != nil {
+			err = checkBytesList(prefix+"topics", expectedLog.Topics, logs[i].Topics)
+			if err != nil {
+				return err
+			}
+		}
+		if expectedLog.Data != nil {
+			err = checkBytesList(prefix+"data", expectedLog.Data, logs[i].Data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkOutputAccounts(expectedAccounts map[string]*ExpectedOutputAccount, outputAccounts map[string]*vmcommon.OutputAccount) error {
+	for addressValue, expectedAccount := range expectedAccounts {
+		address, err := parseValue(addressValue)
+		if err != nil {
+			return err
+		}
+
+		outAcc, found := outputAccounts[string(address)]
+		if !found {
+			return fmt.Errorf("%w, output account %s not found", ErrCheckFailed, addressValue)
+		}
+
+		prefix := fmt.Sprintf("output account %s ", addressValue)
+		if len(expectedAccount.BalanceDelta) > 0 {
+			err = checkBigInt(prefix+"balance delta", expectedAccount.BalanceDelta, outAcc.BalanceDelta)
+			if err != nil {
+				return err
+			}
+		}
+		if expectedAccount.OutputTransfers != nil {
+			err = checkOutputTransfers(prefix, expectedAccount.OutputTransfers, outAcc.OutputTransfers)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkOutputTransfers(prefix string, expectedTransfers []*ExpectedOutputTransfer, transfers []vmcommon.OutputTransfer) error {
+	err := checkEqual(prefix+"number of output transfers", len(expectedTransfers), len(transfers))
+	if err != nil {
+		return err
+	}
+
+	for i, expectedTransfer := range expectedTransfers {
+		transferPrefix := fmt.Sprintf("%soutput transfer %d ", prefix, i)
+		err = checkBigInt(transferPrefix+"value", expectedTransfer.Value, transfers[i].Value)
+		if err != nil {
+			return err
+		}
+		err = checkBytes(transferPrefix+"data", expectedTransfer.Data, transfers[i].Data)
+		if err != nil {
+			return err
+		}
+
+		gasLimit, errParse := parseUint64(expectedTransfer.GasLimit)
+		if errParse != nil {
+			return errParse
+		}
+		err = checkEqual(transferPrefix+"gas limit", gasLimit, transfers[i].GasLimit)
+		if err != nil {
+			return err
+		}
+
+		callType, errParse := parseCallType(expectedTransfer.CallType)
+		if errParse != nil {
+			return errParse
+		}
+		err = checkEqual(transferPrefix+"call type", callType.ToString(), transfers[i].CallType.ToString())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (r *runner) IsInterfaceNil() bool {
+	return r == nil
+}
@@ -0,0 +1,279 @@
+package scenarios
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRunner(t *testing.T) *runner {
+	r, err := NewRunner(ArgsNewRunner{
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		Hasher:      sha256.NewSha256(),
+	})
+	require.Nil(t, err)
+
+	return r
+}
+
+func createKeyValueScenario() *Scenario {
+	return &Scenario{
+		Name: "save key value",
+		PreState: &State{
+			Accounts: map[string]*Account{
+				"address:alice": {Balance: "10"},
+			},
+		},
+		Steps: []*Step{
+			{
+				Name:        "save",
+				Caller:      "address:alice",
+				Recipient:   "address:alice",
+				Function:    core.BuiltInFunctionSaveKeyValue,
+				Arguments:   []string{"str:key", "str:value"},
+				GasProvided: "100",
+			},
+		},
+		PostState: &State{
+			Accounts: map[string]*Account{
+				"address:alice": {
+					Balance: "10",
+					Storage: map[string]string{"str:key": "str:value"},
+				},
+			},
+		},
+	}
+}
+
+func createCrossShardScenario() *Scenario {
+	return &Scenario{
+		Name:        "cross shard multi transfer",
+		NumShards:   2,
+		SelfShardID: 1,
+		PreState: &State{
+			Accounts: map[string]*Account{
+				"address:alice": {
+					DCDT: map[string]*TokenData{
+						"str:FNG-abcdef": {Instances: []*TokenInstance{{Nonce: "0", Balance: "100"}}},
+					},
+				},
+			},
+		},
+		Steps: []*Step{
+			{
+				Name:        "transfer and call",
+				Caller:      "address:alice",
+				Recipient:   "address:alice",
+				Function:    core.BuiltInFunctionMultiDCDTNFTTransfer,
+				Arguments:   []string{"sc:contract_other_shard_0", "1", "str:FNG-abcdef", "0", "40", "str:deposit"},
+				GasProvided: "100",
+				Expect: &Expected{
+					OutputAccounts: map[string]*ExpectedOutputAccount{
+						"sc:contract_other_shard_0": {
+							OutputTransfers: []*ExpectedOutputTransfer{
+								{
+									Value:    "0",
+									Data:     "str:MultiDCDTNFTTransfer@01@464e472d616263646566@00@28@6465706f736974",
+									GasLimit: "99",
+									CallType: "directCall",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewRunner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRunner(ArgsNewRunner{Hasher: sha256.NewSha256()})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRunner(ArgsNewRunner{Marshalizer: &marshal.GogoProtoMarshalizer{}})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		r := createRunner(t)
+		assert.False(t, check.IfNil(r))
+	})
+}
+
+func TestRunner_RunFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := createRunner(t).RunFile(filepath.Join("testdata", "missing.json"))
+		assert.NotNil(t, err)
+	})
+	t.Run("testdata scenarios should pass", func(t *testing.T) {
+		t.Parallel()
+
+		files, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+		require.Nil(t, err)
+		require.NotEmpty(t, files)
+
+		r := createRunner(t)
+		for _, file := range files {
+			assert.Nil(t, r.RunFile(file), file)
+		}
+	})
+}
+
+func TestRunner_RunScenario(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil scenario should error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ErrNilScenario, createRunner(t).RunScenario(nil))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, createRunner(t).RunScenario(createKeyValueScenario()))
+	})
+	t.Run("invalid pre-state value should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.PreState.Accounts["address:alice"].Balance = "-1"
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("unexpected error should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.Steps[0].Arguments = nil
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrUnexpectedError))
+	})
+	t.Run("missing expected error should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.Steps[0].Expect = &Expected{Error: "invalid arguments"}
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("expected error should pass", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.Steps[0].Arguments = nil
+		scenario.Steps[0].Expect = &Expected{Error: "invalid arguments"}
+		scenario.PostState = nil
+		assert.Nil(t, createRunner(t).RunScenario(scenario))
+	})
+	t.Run("wrong gas remaining should error", func(t *testing.T) {
+		t.Parallel()
+
+		gasRemaining := "100"
+		scenario := createKeyValueScenario()
+		scenario.Steps[0].Expect = &Expected{GasRemaining: &gasRemaining}
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("wrong logs should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.Steps[0].Expect = &Expected{Logs: []*ExpectedLog{{Identifier: "str:SaveKeyValue"}}}
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("expected output transfers should pass", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, createRunner(t).RunScenario(createCrossShardScenario()))
+	})
+	t.Run("missing output account should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.Steps[0].Expect = &Expected{
+			OutputAccounts: map[string]*ExpectedOutputAccount{
+				"address:bob": {},
+			},
+		}
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("wrong output transfer should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTransfer := func(scenario *Scenario) *ExpectedOutputTransfer {
+			return scenario.Steps[0].Expect.OutputAccounts["sc:contract_other_shard_0"].OutputTransfers[0]
+		}
+
+		scenario := createCrossShardScenario()
+		expectedTransfer(scenario).GasLimit = "100"
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+
+		scenario = createCrossShardScenario()
+		expectedTransfer(scenario).Data = "str:MultiDCDTNFTTransfer"
+		err = createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+
+		scenario = createCrossShardScenario()
+		expectedTransfer(scenario).CallType = "asynchronousCall"
+		err = createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+
+		scenario = createCrossShardScenario()
+		expectedTransfer(scenario).CallType = "unknownCallType"
+		err = createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		scenario = createCrossShardScenario()
+		scenario.Steps[0].Expect.OutputAccounts["sc:contract_other_shard_0"].OutputTransfers = []*ExpectedOutputTransfer{}
+		err = createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("wrong post-state storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.PostState.Accounts["address:alice"].Storage["str:key"] = "str:other"
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("wrong post-state balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.PostState.Accounts["address:alice"].Balance = "11"
+		err := createRunner(t).RunScenario(scenario)
+		assert.True(t, errors.Is(err, ErrCheckFailed))
+	})
+	t.Run("disabled flags should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createKeyValueScenario()
+		scenario.DisabledFlags = []string{"FixGasRemainingForSaveKeyValueFlag"}
+		assert.Nil(t, createRunner(t).RunScenario(scenario))
+	})
+}
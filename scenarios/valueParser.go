@@ -0,0 +1,124 @@
+package scenarios
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+const (
+	strPrefix     = "str:"
+	addressPrefix = "address:"
+	scPrefix      = "sc:"
+	hexPrefix     = "0x"
+	addressLen    = 32
+	paddingChar   = '_'
+)
+
+var scVMType = []byte{5, 0}
+
+// parseValue converts a scenario value into bytes. The supported formats are:
+//   - "" for empty bytes
+//   - "str:text" for the raw text bytes
+//   - "address:name" for a 32 bytes user address made of the name padded with '_'
+//   - "sc:name" for a 32 bytes smart contract address made of the name padded with '_'
+//   - "0x0a0b" for hex encoded bytes
+//   - "1_000" for the big endian bytes of an unsigned decimal number
+func parseValue(value string) ([]byte, error) {
+	switch {
+	case len(value) == 0:
+		return make([]byte, 0), nil
+	case strings.HasPrefix(value, strPrefix):
+		return []byte(strings.TrimPrefix(value, strPrefix)), nil
+	case strings.HasPrefix(value, addressPrefix):
+		return padAddress(nil, strings.TrimPrefix(value, addressPrefix))
+	case strings.HasPrefix(value, scPrefix):
+		prefix := make([]byte, vmcommon.NumInitCharactersForScAddress-vmcommon.VMTypeLen)
+		prefix = append(prefix, scVMType...)
+		return padAddress(prefix, strings.TrimPrefix(value, scPrefix))
+	case strings.HasPrefix(value, hexPrefix):
+		decoded, err := hex.DecodeString(strings.TrimPrefix(value, hexPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", ErrInvalidValue, value, err.Error())
+		}
+		return decoded, nil
+	default:
+		number, ok := big.NewInt(0).SetString(strings.ReplaceAll(value, "_", ""), 10)
+		if !ok || number.Sign() < 0 {
+			return nil, fmt.Errorf("%w %s", ErrInvalidValue, value)
+		}
+		return number.Bytes(), nil
+	}
+}
+
+func padAddress(prefix []byte, name string) ([]byte, error) {
+	if len(prefix)+len(name) > addressLen {
+		return nil, fmt.Errorf("%w, address name too long: %s", ErrInvalidValue, name)
+	}
+
+	address := append(prefix, []byte(name)...)
+	return append(address, bytes.Repeat([]byte{paddingChar}, addressLen-len(address))...), nil
+}
+
+func parseValues(values []string) ([][]byte, error) {
+	result := make([][]byte, 0, len(values))
+	for _, value := range values {
+		parsed, err := parseValue(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+
+	return result, nil
+}
+
+func parseBigInt(value string) (*big.Int, error) {
+	parsed, err := parseValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).SetBytes(parsed), nil
+}
+
+func parseUint64(value string) (uint64, error) {
+	number, err := parseBigInt(value)
+	if err != nil {
+		return 0, err
+	}
+	if !number.IsUint64() {
+		return 0, fmt.Errorf("%w %s, does not fit in uint64", ErrInvalidValue, value)
+	}
+
+	return number.Uint64(), nil
+}
+
+var callTypes = []vm.CallType{
+	vm.DirectCall,
+	vm.AsynchronousCall,
+	vm.AsynchronousCallBack,
+	vm.DCDTTransferAndExecute,
+	vm.ExecOnDestByCaller,
+}
+
+// parseCallType converts the call type name, as returned by vm.CallType.ToString, into the call type.
+// An empty value means a direct call.
+func parseCallType(value string) (vm.CallType, error) {
+	if len(value) == 0 {
+		return vm.DirectCall, nil
+	}
+
+	for _, callType := range callTypes {
+		if callType.ToString() == value {
+			return callType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w, unknown call type %s", ErrInvalidValue, value)
+}
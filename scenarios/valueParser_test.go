@@ -0,0 +1,93 @@
+package scenarios
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := parseValue("")
+		assert.Nil(t, err)
+		assert.Empty(t, value)
+	})
+	t.Run("string value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := parseValue("str:TKN-abcdef")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("TKN-abcdef"), value)
+	})
+	t.Run("address value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := parseValue("address:alice")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("alice___________________________"), value)
+		assert.False(t, vmcommon.IsSmartContractAddress(value))
+	})
+	t.Run("smart contract address value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := parseValue("sc:contract")
+		assert.Nil(t, err)
+		assert.Len(t, value, addressLen)
+		assert.True(t, vmcommon.IsSmartContractAddress(value))
+	})
+	t.Run("too long address should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseValue("address:this-name-is-longer-than-32-bytes")
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("hex value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := parseValue("0x0a0b")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{10, 11}, value)
+
+		_, err = parseValue("0xzz")
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("number value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := parseValue("1_000")
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000).Bytes(), value)
+
+		value, err = parseValue("0")
+		assert.Nil(t, err)
+		assert.Empty(t, value)
+
+		_, err = parseValue("-1")
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		_, err = parseValue("abc")
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+}
+
+func TestParseUint64(t *testing.T) {
+	t.Parallel()
+
+	value, err := parseUint64("")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), value)
+
+	value, err = parseUint64("18446744073709551615")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(18446744073709551615), value)
+
+	_, err = parseUint64("18446744073709551616")
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
@@ -5,6 +5,8 @@ import (
 	"math/big"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/abi"
 )
 
 // txDataBuilder constructs a string to be used for transaction arguments
@@ -88,6 +90,11 @@ func (builder *txDataBuilder) Bytes(bytes []byte) *txDataBuilder {
 	return builder
 }
 
+// Address appends an address to the data string.
+func (builder *txDataBuilder) Address(address vmcommon.Address) *txDataBuilder {
+	return builder.Bytes(address)
+}
+
 // Str appends a string to the data string.
 func (builder *txDataBuilder) Str(str string) *txDataBuilder {
 	element := hex.EncodeToString([]byte(str))
@@ -137,6 +144,19 @@ func (builder *txDataBuilder) BigInt(value *big.Int) *txDataBuilder {
 	return builder.Bytes(value.Bytes())
 }
 
+// Typed appends the value, encoded as a top level argument of the provided type, to the data string.
+// The data string remains unchanged if the value does not match the type.
+func (builder *txDataBuilder) Typed(value interface{}, td *abi.TypeDescriptor) error {
+	encoded, err := abi.EncodeTopLevel(value, td)
+	if err != nil {
+		return err
+	}
+
+	builder.Bytes(encoded)
+
+	return nil
+}
+
 // IssueDCDT appends to the data string all the elements required to request an DCDT issuing.
 func (builder *txDataBuilder) IssueDCDT(token string, ticker string, supply int64, numDecimals byte) *txDataBuilder {
 	return builder.Func("issue").Str(token).Str(ticker).Int64(supply).Byte(numDecimals)
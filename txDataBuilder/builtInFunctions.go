@@ -0,0 +1,303 @@
+package txDataBuilder
+
+import (
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+)
+
+// DCDTTransferItem is a single token transfer of a MultiDCDTNFTTransfer call. An REWA transfer uses
+// vmcommon.REWAIdentifier as token and 0 as nonce.
+type DCDTTransferItem struct {
+	Token string
+	Nonce uint64
+	Value *big.Int
+}
+
+// NFTMetaData holds the metadata fields required to create, recreate or update an NFT
+type NFTMetaData struct {
+	Name       []byte
+	Royalties  uint32
+	Hash       []byte
+	Attributes []byte
+	URIs       [][]byte
+}
+
+// KeyValue is a storage key and its value, as required by SaveKeyValue
+type KeyValue struct {
+	Key   []byte
+	Value []byte
+}
+
+// NonceInterval is an inclusive interval of nonces, as required by DCDTDeleteMetadata
+type NonceInterval struct {
+	Start uint64
+	End   uint64
+}
+
+// Uint64 appends an uint64 to the data string.
+func (builder *txDataBuilder) Uint64(value uint64) *txDataBuilder {
+	return builder.BigInt(big.NewInt(0).SetUint64(value))
+}
+
+// CallFunction appends the function to be called after a token transfer, followed by its arguments.
+func (builder *txDataBuilder) CallFunction(function string, arguments ...[]byte) *txDataBuilder {
+	builder.Str(function)
+	for _, argument := range arguments {
+		builder.Bytes(argument)
+	}
+
+	return builder
+}
+
+// ClaimDeveloperRewards sets the data string to claim the developer rewards of a smart contract.
+func (builder *txDataBuilder) ClaimDeveloperRewards() *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionClaimDeveloperRewards)
+}
+
+// ChangeOwnerAddress appends to the data string all the elements required to change the owner of a smart contract.
+func (builder *txDataBuilder) ChangeOwnerAddress(newOwner []byte) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionChangeOwnerAddress).Bytes(newOwner)
+}
+
+// SetUserName appends to the data string all the elements required to set the user name of an account.
+func (builder *txDataBuilder) SetUserName(userName string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionSetUserName).Str(userName)
+}
+
+// DeleteUserName sets the data string to delete the user name of an account.
+func (builder *txDataBuilder) DeleteUserName() *txDataBuilder {
+	return builder.Func(vmcommon.BuiltInFunctionDeleteUserName)
+}
+
+// SaveKeyValue appends to the data string all the key-value pairs to be saved in the account storage.
+func (builder *txDataBuilder) SaveKeyValue(keyValues ...KeyValue) *txDataBuilder {
+	builder.Func(core.BuiltInFunctionSaveKeyValue)
+	for _, keyValue := range keyValues {
+		builder.Bytes(keyValue.Key).Bytes(keyValue.Value)
+	}
+
+	return builder
+}
+
+// PauseDCDT appends to the data string all the elements required to pause a token.
+func (builder *txDataBuilder) PauseDCDT(token string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTPause).Str(token)
+}
+
+// UnPauseDCDT appends to the data string all the elements required to unpause a token.
+func (builder *txDataBuilder) UnPauseDCDT(token string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTUnPause).Str(token)
+}
+
+// SetDCDTRole appends to the data string all the elements required to set the roles of a token.
+func (builder *txDataBuilder) SetDCDTRole(token string, roles ...string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionSetDCDTRole).Str(token).strings(roles)
+}
+
+// UnSetDCDTRole appends to the data string all the elements required to unset the roles of a token.
+func (builder *txDataBuilder) UnSetDCDTRole(token string, roles ...string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionUnSetDCDTRole).Str(token).strings(roles)
+}
+
+// LocalMintDCDT appends to the data string all the elements required to mint fungible tokens.
+func (builder *txDataBuilder) LocalMintDCDT(token string, value *big.Int) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTLocalMint).Str(token).BigInt(value)
+}
+
+// LocalBurnDCDT appends to the data string all the elements required to burn fungible tokens.
+func (builder *txDataBuilder) LocalBurnDCDT(token string, value *big.Int) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTLocalBurn).Str(token).BigInt(value)
+}
+
+// FreezeDCDT appends to the data string all the elements required to freeze a token. A nonce of 0 freezes the
+// fungible token, otherwise the NFT with the provided nonce.
+func (builder *txDataBuilder) FreezeDCDT(token string, nonce uint64) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTFreeze).tokenKey(token, nonce)
+}
+
+// UnFreezeDCDT appends to the data string all the elements required to unfreeze a token.
+func (builder *txDataBuilder) UnFreezeDCDT(token string, nonce uint64) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTUnFreeze).tokenKey(token, nonce)
+}
+
+// WipeDCDT appends to the data string all the elements required to wipe a token.
+func (builder *txDataBuilder) WipeDCDT(token string, nonce uint64) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTWipe).tokenKey(token, nonce)
+}
+
+// SetLimitedTransferDCDT appends to the data string all the elements required to limit the transfers of a token.
+func (builder *txDataBuilder) SetLimitedTransferDCDT(token string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTSetLimitedTransfer).Str(token)
+}
+
+// UnSetLimitedTransferDCDT appends to the data string all the elements required to remove the transfer limitation.
+func (builder *txDataBuilder) UnSetLimitedTransferDCDT(token string) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTUnSetLimitedTransfer).Str(token)
+}
+
+// SetBurnRoleForAllDCDT appends to the data string all the elements required to allow everyone to burn a token.
+func (builder *txDataBuilder) SetBurnRoleForAllDCDT(token string) *txDataBuilder {
+	return builder.Func(vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll).Str(token)
+}
+
+// UnSetBurnRoleForAllDCDT appends to the data string all the elements required to remove the burn role for all.
+func (builder *txDataBuilder) UnSetBurnRoleForAllDCDT(token string) *txDataBuilder {
+	return builder.Func(vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll).Str(token)
+}
+
+// TransferRoleAddAddress appends to the data string all the elements required to add addresses with transfer role.
+func (builder *txDataBuilder) TransferRoleAddAddress(token string, addresses ...[]byte) *txDataBuilder {
+	return builder.Func(vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress).Str(token).byteSlices(addresses)
+}
+
+// TransferRoleDeleteAddress appends to the data string all the elements required to remove addresses with transfer role.
+func (builder *txDataBuilder) TransferRoleDeleteAddress(token string, addresses ...[]byte) *txDataBuilder {
+	return builder.Func(vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress).Str(token).byteSlices(addresses)
+}
+
+// SetTokenTypeDCDT appends to the data string all the elements required to set the type of a token.
+func (builder *txDataBuilder) SetTokenTypeDCDT(token string, tokenType string) *txDataBuilder {
+	return builder.Func(core.DCDTSetTokenType).Str(token).Str(tokenType)
+}
+
+// CreateDCDTNFT appends to the data string all the elements required to create an NFT.
+func (builder *txDataBuilder) CreateDCDTNFT(token string, quantity *big.Int, metaData *NFTMetaData) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTNFTCreate).Str(token).BigInt(quantity).metaData(metaData)
+}
+
+// AddQuantityDCDTNFT appends to the data string all the elements required to add quantity to an NFT.
+func (builder *txDataBuilder) AddQuantityDCDTNFT(token string, nonce uint64, quantity *big.Int) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTNFTAddQuantity).Str(token).Uint64(nonce).BigInt(quantity)
+}
+
+// BurnDCDTNFT appends to the data string all the elements required to burn a quantity of an NFT.
+func (builder *txDataBuilder) BurnDCDTNFT(token string, nonce uint64, quantity *big.Int) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTNFTBurn).Str(token).Uint64(nonce).BigInt(quantity)
+}
+
+// AddURIsDCDTNFT appends to the data string all the elements required to add URIs to an NFT.
+func (builder *txDataBuilder) AddURIsDCDTNFT(token string, nonce uint64, uris ...[]byte) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTNFTAddURI).Str(token).Uint64(nonce).byteSlices(uris)
+}
+
+// UpdateAttributesDCDTNFT appends to the data string all the elements required to update the attributes of an NFT.
+func (builder *txDataBuilder) UpdateAttributesDCDTNFT(token string, nonce uint64, attributes []byte) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTNFTUpdateAttributes).Str(token).Uint64(nonce).Bytes(attributes)
+}
+
+// TransferDCDTNFTCreateRole appends to the data string all the elements required to move the NFT create role.
+func (builder *txDataBuilder) TransferDCDTNFTCreateRole(token string, destination []byte) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionDCDTNFTCreateRoleTransfer).Str(token).Bytes(destination)
+}
+
+// MultiTransferDCDTNFT appends to the data string all the elements required to transfer multiple tokens, REWA
+// included, to the destination. The function to be called on the destination can be appended with CallFunction.
+func (builder *txDataBuilder) MultiTransferDCDTNFT(destination []byte, transfers ...*DCDTTransferItem) *txDataBuilder {
+	builder.Func(core.BuiltInFunctionMultiDCDTNFTTransfer).Bytes(destination).Int(len(transfers))
+	for _, transfer := range transfers {
+		builder.Str(transfer.Token).Uint64(transfer.Nonce).BigInt(transfer.Value)
+	}
+
+	return builder
+}
+
+// RecreateDCDTMetaData appends to the data string all the elements required to recreate the metadata of an NFT.
+func (builder *txDataBuilder) RecreateDCDTMetaData(token string, nonce uint64, metaData *NFTMetaData) *txDataBuilder {
+	return builder.Func(core.DCDTMetaDataRecreate).Str(token).Uint64(nonce).metaData(metaData)
+}
+
+// UpdateDCDTMetaData appends to the data string all the elements required to update the metadata of an NFT.
+func (builder *txDataBuilder) UpdateDCDTMetaData(token string, nonce uint64, metaData *NFTMetaData) *txDataBuilder {
+	return builder.Func(core.DCDTMetaDataUpdate).Str(token).Uint64(nonce).metaData(metaData)
+}
+
+// SetNewURIsDCDT appends to the data string all the elements required to replace the URIs of an NFT.
+func (builder *txDataBuilder) SetNewURIsDCDT(token string, nonce uint64, uris ...[]byte) *txDataBuilder {
+	return builder.Func(core.DCDTSetNewURIs).Str(token).Uint64(nonce).byteSlices(uris)
+}
+
+// ModifyRoyaltiesDCDT appends to the data string all the elements required to change the royalties of an NFT.
+func (builder *txDataBuilder) ModifyRoyaltiesDCDT(token string, nonce uint64, royalties uint32) *txDataBuilder {
+	return builder.Func(core.DCDTModifyRoyalties).Str(token).Uint64(nonce).Uint64(uint64(royalties))
+}
+
+// ModifyCreatorDCDT appends to the data string all the elements required to set the caller as creator of an NFT.
+func (builder *txDataBuilder) ModifyCreatorDCDT(token string, nonce uint64) *txDataBuilder {
+	return builder.Func(core.DCDTModifyCreator).Str(token).Uint64(nonce)
+}
+
+// AddMetadataDCDT appends to the data string all the elements required to add the marshalled metadata of an NFT to
+// the system account.
+func (builder *txDataBuilder) AddMetadataDCDT(token string, nonce uint64, marshalledMetaData []byte) *txDataBuilder {
+	return builder.Func(vmcommon.DCDTAddMetadata).Str(token).Uint64(nonce).Bytes(marshalledMetaData)
+}
+
+// DeleteMetadataDCDT appends to the data string all the elements required to delete the metadata of the NFTs with
+// nonces in the provided intervals from the system account.
+func (builder *txDataBuilder) DeleteMetadataDCDT(token string, intervals ...NonceInterval) *txDataBuilder {
+	builder.Func(vmcommon.DCDTDeleteMetadata).Str(token).Int(len(intervals))
+	for _, interval := range intervals {
+		builder.Uint64(interval.Start).Uint64(interval.End)
+	}
+
+	return builder
+}
+
+// SetGuardian appends to the data string all the elements required to set the guardian of an account.
+func (builder *txDataBuilder) SetGuardian(guardian []byte, serviceUID []byte) *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionSetGuardian).Bytes(guardian).Bytes(serviceUID)
+}
+
+// GuardAccount sets the data string to guard an account with its active guardian.
+func (builder *txDataBuilder) GuardAccount() *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionGuardAccount)
+}
+
+// UnGuardAccount sets the data string to remove the guard of an account.
+func (builder *txDataBuilder) UnGuardAccount() *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionUnGuardAccount)
+}
+
+// MigrateDataTrie sets the data string to migrate the data trie of an account.
+func (builder *txDataBuilder) MigrateDataTrie() *txDataBuilder {
+	return builder.Func(core.BuiltInFunctionMigrateDataTrie)
+}
+
+func (builder *txDataBuilder) tokenKey(token string, nonce uint64) *txDataBuilder {
+	key := []byte(token)
+	if nonce > 0 {
+		key = append(key, big.NewInt(0).SetUint64(nonce).Bytes()...)
+	}
+
+	return builder.Bytes(key)
+}
+
+func (builder *txDataBuilder) metaData(metaData *NFTMetaData) *txDataBuilder {
+	if metaData == nil {
+		metaData = &NFTMetaData{}
+	}
+
+	return builder.Bytes(metaData.Name).
+		Uint64(uint64(metaData.Royalties)).
+		Bytes(metaData.Hash).
+		Bytes(metaData.Attributes).
+		byteSlices(metaData.URIs)
+}
+
+func (builder *txDataBuilder) strings(values []string) *txDataBuilder {
+	for _, value := range values {
+		builder.Str(value)
+	}
+
+	return builder
+}
+
+func (builder *txDataBuilder) byteSlices(values [][]byte) *txDataBuilder {
+	for _, value := range values {
+		builder.Bytes(value)
+	}
+
+	return builder
+}
@@ -0,0 +1,489 @@
+package txDataBuilder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/builtInFunctions"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/mock"
+	"github.com/TerraDharitri/drt-go-chain-vm-common/parsers"
+	datafield "github.com/TerraDharitri/drt-go-chain-vm-common/parsers/dataField"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fungibleToken = "TKN-abcdef"
+	nftToken      = "NFT-123456"
+	nftNonce      = uint64(5)
+	nftIdentifier = "NFT-123456-05"
+)
+
+var (
+	senderAddress   = bytes.Repeat([]byte{1}, 32)
+	otherAddress    = bytes.Repeat([]byte{2}, 32)
+	contractAddress = append(make([]byte, vmcommon.NumInitCharactersForScAddress), bytes.Repeat([]byte{3}, 22)...)
+)
+
+type builtInFunctionCase struct {
+	name              string
+	build             func(builder *txDataBuilder) *txDataBuilder
+	expectedFunction  string
+	expectedArguments [][]byte
+	expectedTokens    []string
+	expectedValues    []string
+	expectedCall      string
+}
+
+func createNFTMetaData() *NFTMetaData {
+	return &NFTMetaData{
+		Name:       []byte("name"),
+		Royalties:  1000,
+		Hash:       []byte("hash"),
+		Attributes: []byte("attributes"),
+		URIs:       [][]byte{[]byte("uri1"), []byte("uri2")},
+	}
+}
+
+func createNFTMetaDataArguments() [][]byte {
+	return [][]byte{[]byte("name"), {0x03, 0xe8}, []byte("hash"), []byte("attributes"), []byte("uri1"), []byte("uri2")}
+}
+
+func createBuiltInFunctionCases() []*builtInFunctionCase {
+	nftArguments := [][]byte{[]byte(nftToken), {byte(nftNonce)}}
+
+	return []*builtInFunctionCase{
+		{
+			name:              "ClaimDeveloperRewards",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.ClaimDeveloperRewards() },
+			expectedFunction:  core.BuiltInFunctionClaimDeveloperRewards,
+			expectedArguments: [][]byte{},
+		},
+		{
+			name:              "ChangeOwnerAddress",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.ChangeOwnerAddress(otherAddress) },
+			expectedFunction:  core.BuiltInFunctionChangeOwnerAddress,
+			expectedArguments: [][]byte{otherAddress},
+		},
+		{
+			name:              "SetUserName",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.SetUserName("user.numbat") },
+			expectedFunction:  core.BuiltInFunctionSetUserName,
+			expectedArguments: [][]byte{[]byte("user.numbat")},
+		},
+		{
+			name:              "DeleteUserName",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.DeleteUserName() },
+			expectedFunction:  vmcommon.BuiltInFunctionDeleteUserName,
+			expectedArguments: [][]byte{},
+		},
+		{
+			name: "SaveKeyValue",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.SaveKeyValue(KeyValue{Key: []byte("k1"), Value: []byte("v1")}, KeyValue{Key: []byte("k2"), Value: []byte("v2")})
+			},
+			expectedFunction:  core.BuiltInFunctionSaveKeyValue,
+			expectedArguments: [][]byte{[]byte("k1"), []byte("v1"), []byte("k2"), []byte("v2")},
+		},
+		{
+			name:              "PauseDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.PauseDCDT(fungibleToken) },
+			expectedFunction:  core.BuiltInFunctionDCDTPause,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "UnPauseDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.UnPauseDCDT(fungibleToken) },
+			expectedFunction:  core.BuiltInFunctionDCDTUnPause,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name: "SetDCDTRole",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.SetDCDTRole(fungibleToken, core.DCDTRoleLocalMint, core.DCDTRoleLocalBurn)
+			},
+			expectedFunction:  core.BuiltInFunctionSetDCDTRole,
+			expectedArguments: [][]byte{[]byte(fungibleToken), []byte(core.DCDTRoleLocalMint), []byte(core.DCDTRoleLocalBurn)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "UnSetDCDTRole",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.UnSetDCDTRole(fungibleToken, core.DCDTRoleLocalMint) },
+			expectedFunction:  core.BuiltInFunctionUnSetDCDTRole,
+			expectedArguments: [][]byte{[]byte(fungibleToken), []byte(core.DCDTRoleLocalMint)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "TransferDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.TransferDCDT(fungibleToken, 100) },
+			expectedFunction:  core.BuiltInFunctionDCDTTransfer,
+			expectedArguments: [][]byte{[]byte(fungibleToken), {100}},
+			expectedTokens:    []string{fungibleToken},
+			expectedValues:    []string{"100"},
+		},
+		{
+			name:              "BurnDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.BurnDCDT(fungibleToken, 100) },
+			expectedFunction:  core.BuiltInFunctionDCDTBurn,
+			expectedArguments: [][]byte{[]byte(fungibleToken), {100}},
+		},
+		{
+			name:              "LocalMintDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.LocalMintDCDT(fungibleToken, big.NewInt(258)) },
+			expectedFunction:  core.BuiltInFunctionDCDTLocalMint,
+			expectedArguments: [][]byte{[]byte(fungibleToken), {1, 2}},
+			expectedTokens:    []string{fungibleToken},
+			expectedValues:    []string{"258"},
+		},
+		{
+			name:              "LocalBurnDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.LocalBurnDCDT(fungibleToken, big.NewInt(258)) },
+			expectedFunction:  core.BuiltInFunctionDCDTLocalBurn,
+			expectedArguments: [][]byte{[]byte(fungibleToken), {1, 2}},
+			expectedTokens:    []string{fungibleToken},
+			expectedValues:    []string{"258"},
+		},
+		{
+			name:              "FreezeDCDT fungible",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.FreezeDCDT(fungibleToken, 0) },
+			expectedFunction:  core.BuiltInFunctionDCDTFreeze,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "FreezeDCDT NFT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.FreezeDCDT(nftToken, nftNonce) },
+			expectedFunction:  core.BuiltInFunctionDCDTFreeze,
+			expectedArguments: [][]byte{append([]byte(nftToken), byte(nftNonce))},
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name:              "UnFreezeDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.UnFreezeDCDT(nftToken, nftNonce) },
+			expectedFunction:  core.BuiltInFunctionDCDTUnFreeze,
+			expectedArguments: [][]byte{append([]byte(nftToken), byte(nftNonce))},
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name:              "WipeDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.WipeDCDT(fungibleToken, 0) },
+			expectedFunction:  core.BuiltInFunctionDCDTWipe,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "SetLimitedTransferDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.SetLimitedTransferDCDT(fungibleToken) },
+			expectedFunction:  core.BuiltInFunctionDCDTSetLimitedTransfer,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "UnSetLimitedTransferDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.UnSetLimitedTransferDCDT(fungibleToken) },
+			expectedFunction:  core.BuiltInFunctionDCDTUnSetLimitedTransfer,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "SetBurnRoleForAllDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.SetBurnRoleForAllDCDT(fungibleToken) },
+			expectedFunction:  vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "UnSetBurnRoleForAllDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.UnSetBurnRoleForAllDCDT(fungibleToken) },
+			expectedFunction:  vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll,
+			expectedArguments: [][]byte{[]byte(fungibleToken)},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name: "TransferRoleAddAddress",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.TransferRoleAddAddress(fungibleToken, senderAddress, otherAddress)
+			},
+			expectedFunction:  vmcommon.BuiltInFunctionDCDTTransferRoleAddAddress,
+			expectedArguments: [][]byte{[]byte(fungibleToken), senderAddress, otherAddress},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "TransferRoleDeleteAddress",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.TransferRoleDeleteAddress(fungibleToken, otherAddress) },
+			expectedFunction:  vmcommon.BuiltInFunctionDCDTTransferRoleDeleteAddress,
+			expectedArguments: [][]byte{[]byte(fungibleToken), otherAddress},
+			expectedTokens:    []string{fungibleToken},
+		},
+		{
+			name:              "SetTokenTypeDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.SetTokenTypeDCDT(nftToken, core.NonFungibleDCDTv2) },
+			expectedFunction:  core.DCDTSetTokenType,
+			expectedArguments: [][]byte{[]byte(nftToken), []byte(core.NonFungibleDCDTv2)},
+			expectedTokens:    []string{nftToken},
+		},
+		{
+			name: "CreateDCDTNFT",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.CreateDCDTNFT(nftToken, big.NewInt(1), createNFTMetaData())
+			},
+			expectedFunction:  core.BuiltInFunctionDCDTNFTCreate,
+			expectedArguments: append([][]byte{[]byte(nftToken), {1}}, createNFTMetaDataArguments()...),
+			expectedTokens:    []string{nftToken},
+			expectedValues:    []string{"1"},
+		},
+		{
+			name:              "AddQuantityDCDTNFT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.AddQuantityDCDTNFT(nftToken, nftNonce, big.NewInt(10)) },
+			expectedFunction:  core.BuiltInFunctionDCDTNFTAddQuantity,
+			expectedArguments: append(nftArguments, []byte{10}),
+			expectedTokens:    []string{nftIdentifier},
+			expectedValues:    []string{"10"},
+		},
+		{
+			name:              "BurnDCDTNFT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.BurnDCDTNFT(nftToken, nftNonce, big.NewInt(10)) },
+			expectedFunction:  core.BuiltInFunctionDCDTNFTBurn,
+			expectedArguments: append(nftArguments, []byte{10}),
+			expectedTokens:    []string{nftIdentifier},
+			expectedValues:    []string{"10"},
+		},
+		{
+			name:              "AddURIsDCDTNFT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.AddURIsDCDTNFT(nftToken, nftNonce, []byte("uri")) },
+			expectedFunction:  core.BuiltInFunctionDCDTNFTAddURI,
+			expectedArguments: append(nftArguments, []byte("uri")),
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name: "UpdateAttributesDCDTNFT",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.UpdateAttributesDCDTNFT(nftToken, nftNonce, []byte("attr"))
+			},
+			expectedFunction:  core.BuiltInFunctionDCDTNFTUpdateAttributes,
+			expectedArguments: append(nftArguments, []byte("attr")),
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name:              "TransferDCDTNFTCreateRole",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.TransferDCDTNFTCreateRole(nftToken, otherAddress) },
+			expectedFunction:  core.BuiltInFunctionDCDTNFTCreateRoleTransfer,
+			expectedArguments: [][]byte{[]byte(nftToken), otherAddress},
+			expectedTokens:    []string{nftToken},
+		},
+		{
+			name: "TransferDCDTNFT with call",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.TransferDCDTNFT(nftToken, int(nftNonce), 2).Bytes(contractAddress).CallFunction("claim", []byte{7})
+			},
+			expectedFunction:  core.BuiltInFunctionDCDTNFTTransfer,
+			expectedArguments: append(nftArguments, []byte{2}, contractAddress, []byte("claim"), []byte{7}),
+			expectedTokens:    []string{nftIdentifier},
+			expectedValues:    []string{"2"},
+			expectedCall:      "claim",
+		},
+		{
+			name: "MultiTransferDCDTNFT with REWA",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.MultiTransferDCDTNFT(otherAddress,
+					&DCDTTransferItem{Token: vmcommon.REWAIdentifier, Value: big.NewInt(100)},
+					&DCDTTransferItem{Token: nftToken, Nonce: nftNonce, Value: big.NewInt(2)},
+				)
+			},
+			expectedFunction: core.BuiltInFunctionMultiDCDTNFTTransfer,
+			expectedArguments: [][]byte{
+				otherAddress, {2},
+				[]byte(vmcommon.REWAIdentifier), {}, {100},
+				[]byte(nftToken), {byte(nftNonce)}, {2},
+			},
+			expectedTokens: []string{vmcommon.REWAIdentifier, nftIdentifier},
+			expectedValues: []string{"100", "2"},
+		},
+		{
+			name: "RecreateDCDTMetaData",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.RecreateDCDTMetaData(nftToken, nftNonce, createNFTMetaData())
+			},
+			expectedFunction:  core.DCDTMetaDataRecreate,
+			expectedArguments: append(nftArguments, createNFTMetaDataArguments()...),
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name: "UpdateDCDTMetaData",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.UpdateDCDTMetaData(nftToken, nftNonce, createNFTMetaData())
+			},
+			expectedFunction:  core.DCDTMetaDataUpdate,
+			expectedArguments: append(nftArguments, createNFTMetaDataArguments()...),
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name:              "SetNewURIsDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.SetNewURIsDCDT(nftToken, nftNonce, []byte("uri")) },
+			expectedFunction:  core.DCDTSetNewURIs,
+			expectedArguments: append(nftArguments, []byte("uri")),
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name:              "ModifyRoyaltiesDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.ModifyRoyaltiesDCDT(nftToken, nftNonce, 500) },
+			expectedFunction:  core.DCDTModifyRoyalties,
+			expectedArguments: append(nftArguments, []byte{0x01, 0xf4}),
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name:              "ModifyCreatorDCDT",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.ModifyCreatorDCDT(nftToken, nftNonce) },
+			expectedFunction:  core.DCDTModifyCreator,
+			expectedArguments: nftArguments,
+			expectedTokens:    []string{nftIdentifier},
+		},
+		{
+			name: "AddMetadataDCDT",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.AddMetadataDCDT(nftToken, nftNonce, []byte("metadata"))
+			},
+			expectedFunction:  vmcommon.DCDTAddMetadata,
+			expectedArguments: append(nftArguments, []byte("metadata")),
+		},
+		{
+			name: "DeleteMetadataDCDT",
+			build: func(b *txDataBuilder) *txDataBuilder {
+				return b.DeleteMetadataDCDT(nftToken, NonceInterval{Start: 1, End: 5}, NonceInterval{Start: 7, End: 7})
+			},
+			expectedFunction:  vmcommon.DCDTDeleteMetadata,
+			expectedArguments: [][]byte{[]byte(nftToken), {2}, {1}, {5}, {7}, {7}},
+		},
+		{
+			name:              "SetGuardian",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.SetGuardian(otherAddress, []byte("uid")) },
+			expectedFunction:  core.BuiltInFunctionSetGuardian,
+			expectedArguments: [][]byte{otherAddress, []byte("uid")},
+		},
+		{
+			name:              "GuardAccount",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.GuardAccount() },
+			expectedFunction:  core.BuiltInFunctionGuardAccount,
+			expectedArguments: [][]byte{},
+		},
+		{
+			name:              "UnGuardAccount",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.UnGuardAccount() },
+			expectedFunction:  core.BuiltInFunctionUnGuardAccount,
+			expectedArguments: [][]byte{},
+		},
+		{
+			name:              "MigrateDataTrie",
+			build:             func(b *txDataBuilder) *txDataBuilder { return b.MigrateDataTrie() },
+			expectedFunction:  core.BuiltInFunctionMigrateDataTrie,
+			expectedArguments: [][]byte{},
+		},
+	}
+}
+
+func TestTxDataBuilder_BuiltInFunctionsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	callArgsParser := parsers.NewCallArgsParser()
+	dataFieldParser, err := datafield.NewOperationDataFieldParser(&datafield.ArgsOperationDataFieldParser{
+		AddressLength:    len(senderAddress),
+		Marshalizer:      &mock.MarshalizerMock{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
+	})
+	require.Nil(t, err)
+
+	for _, testCase := range createBuiltInFunctionCases() {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tc.build(NewBuilder()).ToString()
+
+			function, arguments, errParse := callArgsParser.ParseData(data)
+			require.Nil(t, errParse)
+			assert.Equal(t, tc.expectedFunction, function)
+			assert.Equal(t, tc.expectedArguments, arguments)
+
+			parsed := dataFieldParser.Parse([]byte(data), senderAddress, senderAddress)
+			assert.Equal(t, tc.expectedFunction, parsed.Operation)
+			assert.Equal(t, tc.expectedTokens, parsed.Tokens)
+			assert.Equal(t, tc.expectedValues, parsed.DCDTValues)
+			assert.Equal(t, tc.expectedCall, parsed.Function)
+		})
+	}
+}
+
+func TestTxDataBuilder_AllBuiltInFunctionsAreCovered(t *testing.T) {
+	t.Parallel()
+
+	gasMap := map[string]map[string]uint64{
+		core.BaseOperationCostString: createGasCosts(vmcommon.BaseOperationCost{}),
+		core.BuiltInCostString:       createGasCosts(vmcommon.BuiltInCost{}),
+	}
+	factory, err := builtInFunctions.NewBuiltInFunctionsCreator(builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:                           gasMap,
+		MapDNSAddresses:                  make(map[string]struct{}),
+		MapDNSV2Addresses:                make(map[string]struct{}),
+		Marshalizer:                      &mock.MarshalizerMock{},
+		Accounts:                         &mock.AccountsStub{},
+		ShardCoordinator:                 mock.NewMultiShardsCoordinatorMock(1),
+		EnableEpochsHandler:              &mock.EnableEpochsHandlerStub{},
+		GuardedAccountHandler:            &mock.GuardedAccountHandlerStub{},
+		MaxNumOfAddressesForTransferRole: 100,
+	})
+	require.Nil(t, err)
+	require.Nil(t, factory.CreateBuiltInFunctionContainer())
+
+	covered := make(map[string]struct{})
+	for _, testCase := range createBuiltInFunctionCases() {
+		covered[testCase.expectedFunction] = struct{}{}
+	}
+
+	for function := range factory.BuiltInFunctionContainer().Keys() {
+		_, found := covered[function]
+		assert.True(t, found, "missing builder for %s", function)
+	}
+}
+
+func TestTxDataBuilder_NilMetaDataShouldWriteEmptyFields(t *testing.T) {
+	t.Parallel()
+
+	data := NewBuilder().CreateDCDTNFT(nftToken, big.NewInt(1), nil).ToString()
+	assert.Equal(t, NewBuilder().CreateDCDTNFT(nftToken, big.NewInt(1), &NFTMetaData{}).ToString(), data)
+	assert.Equal(t, core.BuiltInFunctionDCDTNFTCreate+"@"+hex.EncodeToString([]byte(nftToken))+"@01@@@@", data)
+
+	data = NewBuilder().RecreateDCDTMetaData(nftToken, nftNonce, nil).ToString()
+	assert.Equal(t, NewBuilder().RecreateDCDTMetaData(nftToken, nftNonce, &NFTMetaData{}).ToString(), data)
+
+	data = NewBuilder().UpdateDCDTMetaData(nftToken, nftNonce, nil).ToString()
+	assert.Equal(t, NewBuilder().UpdateDCDTMetaData(nftToken, nftNonce, &NFTMetaData{}).ToString(), data)
+}
+
+func createGasCosts(gasCosts interface{}) map[string]uint64 {
+	costsType := reflect.TypeOf(gasCosts)
+	costs := make(map[string]uint64, costsType.NumField())
+	for i := 0; i < costsType.NumField(); i++ {
+		costs[costsType.Field(i).Name] = 1
+	}
+
+	return costs
+}
+
+func TestTxDataBuilder_Address(t *testing.T) {
+	t.Parallel()
+
+	address := vmcommon.Address(contractAddress)
+	data := NewBuilder().Func(core.BuiltInFunctionChangeOwnerAddress).Address(address).ToString()
+	assert.Equal(t, NewBuilder().ChangeOwnerAddress(contractAddress).ToString(), data)
+
+	_, arguments, err := parsers.NewCallArgsParser().ParseData(data)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{address.Bytes()}, arguments)
+}